@@ -18,24 +18,67 @@ package cairo
 
 import (
 	"fmt"
+	"image"
 	"io"
 	"runtime"
 	"unsafe"
 )
 
 /*
-#cgo pkg-config: cairo
+#cgo pkg-config: cairo cairo-ft cairo-pdf cairo-ps cairo-svg cairo-xlib cairo-script cairo-tee
 #include <cairo.h>
+#if CAIRO_HAS_FT_FONT
+#include <cairo-ft.h>
+#endif
+#if CAIRO_HAS_PDF_SURFACE
+#include <cairo-pdf.h>
+#endif
+#if CAIRO_HAS_PS_SURFACE
+#include <cairo-ps.h>
+#endif
 #if CAIRO_HAS_SVG_SURFACE
 #include <cairo-svg.h>
 #endif
 #if CAIRO_HAS_XLIB_SURFACE
 #include <cairo-xlib.h>
 #endif
+#if CAIRO_HAS_SCRIPT_SURFACE
+#include <cairo-script.h>
+#endif
+#if CAIRO_HAS_TEE_SURFACE
+#include <cairo-tee.h>
+#endif
 #include <stdlib.h>
 
 int gocairoWriteFunc(int key, const unsigned char* data, unsigned int length);
 int gocairoReadFunc(int key, const unsigned char* data, unsigned int length);
+void gocairoFreeKey(int key);
+cairo_status_t gocairoUserFontInit(int key, cairo_scaled_font_t *scaled_font,
+                                   cairo_t *cr, cairo_font_extents_t *extents);
+cairo_status_t gocairoUserFontRenderGlyph(int key,
+                                          cairo_scaled_font_t *scaled_font,
+                                          unsigned long glyph, cairo_t *cr,
+                                          cairo_text_extents_t *extents);
+cairo_status_t gocairoUserFontTextToGlyphs(int key,
+                                           cairo_scaled_font_t *scaled_font,
+                                           char *utf8, int utf8_len,
+                                           cairo_glyph_t **glyphs,
+                                           int *num_glyphs,
+                                           cairo_text_cluster_t **clusters,
+                                           int *num_clusters,
+                                           cairo_text_cluster_flags_t *cluster_flags);
+cairo_status_t gocairoUserFontUnicodeToGlyph(int key,
+                                             cairo_scaled_font_t *scaled_font,
+                                             unsigned long unicode,
+                                             unsigned long *glyph_index);
+cairo_surface_t *gocairoRasterSourceAcquire(int key, cairo_surface_t *target,
+                                            cairo_rectangle_int_t *extents);
+void gocairoRasterSourceRelease(int key, cairo_surface_t *surface);
+cairo_status_t gocairoRasterSourceSnapshot(int key);
+cairo_status_t gocairoRasterSourceCopy(int key);
+int gocairoRasterSourceFinish(int key);
+void gocairoSurfaceObserverCallback(int key, cairo_surface_t *observer,
+                                    cairo_surface_t *target);
 
 // A cairo_write_func_t for use in cairo_surface_write_to_png.
 cairo_status_t gocairo_write_func(void *closure,
@@ -54,6 +97,160 @@ cairo_status_t gocairo_read_func(void *closure,
     ? CAIRO_STATUS_SUCCESS
     : CAIRO_STATUS_WRITE_ERROR;
 }
+
+// A cairo_destroy_func_t that releases a closure from newKey.
+void gocairo_free_key(void *closure) {
+  gocairoFreeKey(*(int*)closure);
+  free(closure);
+}
+
+// Key for the user data that holds the closure of a stream surface.
+static cairo_user_data_key_t gocairo_stream_key;
+
+// Key for the user data that holds the UserFont of a user font face.
+static cairo_user_data_key_t gocairo_user_font_key;
+
+// Returns the key of the UserFont behind scaled_font.
+static int gocairo_user_font(cairo_scaled_font_t *scaled_font) {
+  cairo_font_face_t *font_face = cairo_scaled_font_get_font_face(scaled_font);
+  return *(int*)cairo_font_face_get_user_data(font_face,
+                                              &gocairo_user_font_key);
+}
+
+// The callbacks of a user font face, which forward to its UserFont.
+cairo_status_t gocairo_user_font_init(cairo_scaled_font_t *scaled_font,
+                                      cairo_t *cr,
+                                      cairo_font_extents_t *extents) {
+  return gocairoUserFontInit(gocairo_user_font(scaled_font),
+                             scaled_font, cr, extents);
+}
+
+cairo_status_t gocairo_user_font_render_glyph(cairo_scaled_font_t *scaled_font,
+                                              unsigned long glyph,
+                                              cairo_t *cr,
+                                              cairo_text_extents_t *extents) {
+  return gocairoUserFontRenderGlyph(gocairo_user_font(scaled_font),
+                                    scaled_font, glyph, cr, extents);
+}
+
+cairo_status_t gocairo_user_font_text_to_glyphs(cairo_scaled_font_t *scaled_font,
+                                                const char *utf8, int utf8_len,
+                                                cairo_glyph_t **glyphs,
+                                                int *num_glyphs,
+                                                cairo_text_cluster_t **clusters,
+                                                int *num_clusters,
+                                                cairo_text_cluster_flags_t *cluster_flags) {
+  return gocairoUserFontTextToGlyphs(gocairo_user_font(scaled_font),
+                                     scaled_font, (char*)utf8, utf8_len,
+                                     glyphs, num_glyphs,
+                                     clusters, num_clusters, cluster_flags);
+}
+
+cairo_status_t gocairo_user_font_unicode_to_glyph(cairo_scaled_font_t *scaled_font,
+                                                  unsigned long unicode,
+                                                  unsigned long *glyph_index) {
+  return gocairoUserFontUnicodeToGlyph(gocairo_user_font(scaled_font),
+                                       scaled_font, unicode, glyph_index);
+}
+
+// The callbacks of a raster source pattern, which forward to its
+// RasterSource.  The callback data is a key from newKey, shared by the
+// pattern and its copies.
+cairo_surface_t *gocairo_raster_source_acquire(cairo_pattern_t *pattern,
+                                               void *callback_data,
+                                               cairo_surface_t *target,
+                                               const cairo_rectangle_int_t *extents) {
+  return gocairoRasterSourceAcquire(*(int*)callback_data, target,
+                                    (cairo_rectangle_int_t*)extents);
+}
+
+void gocairo_raster_source_release(cairo_pattern_t *pattern,
+                                   void *callback_data,
+                                   cairo_surface_t *surface) {
+  if (surface) {
+    gocairoRasterSourceRelease(*(int*)callback_data, surface);
+    // Drop the reference taken in gocairoRasterSourceAcquire.
+    cairo_surface_destroy(surface);
+  }
+}
+
+cairo_status_t gocairo_raster_source_snapshot(cairo_pattern_t *pattern,
+                                              void *callback_data) {
+  return gocairoRasterSourceSnapshot(*(int*)callback_data);
+}
+
+cairo_status_t gocairo_raster_source_copy(cairo_pattern_t *pattern,
+                                          void *callback_data,
+                                          const cairo_pattern_t *other) {
+  return gocairoRasterSourceCopy(*(int*)callback_data);
+}
+
+void gocairo_raster_source_finish(cairo_pattern_t *pattern,
+                                  void *callback_data) {
+  // Each copy of the pattern gets finished, and the last one frees
+  // the key.
+  if (gocairoRasterSourceFinish(*(int*)callback_data))
+    free(callback_data);
+}
+
+// A cairo_surface_observer_callback_t that forwards to the
+// SurfaceObserverCallback behind data, a key from newObserverKey.
+void gocairo_surface_observer_callback(cairo_surface_t *observer,
+                                       cairo_surface_t *target,
+                                       void *data) {
+  gocairoSurfaceObserverCallback(*(int*)data, observer, target);
+}
+
+#if CAIRO_HAS_FT_FONT
+// Key for the user data that holds the FreeType face of a font face.
+static cairo_user_data_key_t gocairo_ft_key;
+
+// A FreeType face that cairo owns.  Each face gets its own library so
+// that cairo can free it from any thread.
+typedef struct {
+  FT_Library library;
+  void *data;
+} gocairo_ft_face;
+
+void gocairo_ft_face_destroy(void *closure) {
+  gocairo_ft_face *f = closure;
+  if (f->library)
+    FT_Done_FreeType(f->library);
+  free(f->data);
+  free(f);
+}
+
+// Creates a font face for the font in the file at path, or if path is
+// NULL, in the size bytes at data, which the font face takes ownership
+// of.  Returns NULL and sets *ft_err if FreeType can't load the font.
+cairo_font_face_t *gocairo_ft_font_face_create(const char *path,
+                                               void *data, long size,
+                                               long index, int load_flags,
+                                               FT_Error *ft_err) {
+  gocairo_ft_face *f = calloc(1, sizeof(*f));
+  FT_Face face;
+  f->data = data;
+  *ft_err = FT_Init_FreeType(&f->library);
+  if (!*ft_err) {
+    *ft_err = path
+      ? FT_New_Face(f->library, path, index, &face)
+      : FT_New_Memory_Face(f->library, data, size, index, &face);
+  }
+  if (*ft_err) {
+    gocairo_ft_face_destroy(f);
+    return NULL;
+  }
+  cairo_font_face_t *font_face =
+    cairo_ft_font_face_create_for_ft_face(face, load_flags);
+  if (cairo_font_face_set_user_data(font_face, &gocairo_ft_key, f,
+                                    gocairo_ft_face_destroy)) {
+    // The font face is in an error state, so it's safe to free the
+    // FreeType face out from under it.
+    gocairo_ft_face_destroy(f);
+  }
+  return font_face;
+}
+#endif
 */
 import "C"
 
@@ -64,6 +261,9 @@ func (s Status) Error() string {
 
 // WriteToPNG encodes a Surface to an io.Writer as a PNG file.
 func (surface *Surface) WriteToPNG(w io.Writer) error {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	data := writeClosure{w: w}
 	key := goPointers.put(data)
 	status := C.cairo_surface_write_to_png_stream((*C.cairo_surface_t)(surface.Ptr),
@@ -75,6 +275,57 @@ func (surface *Surface) WriteToPNG(w io.Writer) error {
 	return Status(status).toError()
 }
 
+// newKey stashes data in goPointers for as long as some cairo object
+// lives.  Unlike in WriteToPNG, cairo holds on to the closure after the
+// call returns, so the key must live in C memory.  Pass the key to
+// gocairo_free_key once cairo is done with it.
+func newKey(data interface{}) *C.int {
+	key := (*C.int)(C.malloc(C.size_t(unsafe.Sizeof(C.int(0)))))
+	*key = goPointers.put(data)
+	return key
+}
+
+// newStreamKey stashes w for use by gocairo_write_func for as long as a
+// stream surface lives.
+func newStreamKey(w io.Writer) *C.int {
+	return newKey(writeClosure{w: w})
+}
+
+// attachStreamKey arranges for a key from newStreamKey to be released
+// once cairo destroys surface.
+func attachStreamKey(surface *C.cairo_surface_t, key *C.int) {
+	status := C.cairo_surface_set_user_data(surface, &C.gocairo_stream_key,
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		// Error surfaces don't hold user data, so free it now.
+		C.gocairo_free_key(unsafe.Pointer(key))
+	}
+}
+
+// attachDeviceStreamKey is attachStreamKey for stream devices.
+func attachDeviceStreamKey(device *C.cairo_device_t, key *C.int) {
+	status := C.cairo_device_set_user_data(device, &C.gocairo_stream_key,
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		C.gocairo_free_key(unsafe.Pointer(key))
+	}
+}
+
+// newObserverKey stashes f for gocairo_surface_observer_callback for as
+// long as observer lives.
+func newObserverKey(observer *C.cairo_surface_t, f SurfaceObserverCallback) *C.int {
+	key := newKey(f)
+	// Each key is unique, so it doubles as the user data key.
+	status := C.cairo_surface_set_user_data(observer, (*C.cairo_user_data_key_t)(unsafe.Pointer(key)),
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		// observer is an error surface, so the callback never runs.
+		C.gocairo_free_key(unsafe.Pointer(key))
+		return nil
+	}
+	return key
+}
+
 // ImageSurfaceCreateFromPNGStream creates an ImageSurface from a stream of
 // PNG data.
 func ImageSurfaceCreateFromPNGStream(r io.Reader) (*ImageSurface, error) {
@@ -91,6 +342,9 @@ func ImageSurfaceCreateFromPNGStream(r io.Reader) (*ImageSurface, error) {
 
 // PathIter creates an iterator over the segments within the path.
 func (p *Path) Iter() *PathIter {
+	if p.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	return &PathIter{path: p, i: 0}
 }
 
@@ -102,6 +356,10 @@ type PathIter struct {
 
 // Next returns the next PathSegment, or returns nil at the end of the path.
 func (pi *PathIter) Next() *PathSegment {
+	// The path may have been closed since Iter.
+	if pi.path.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	if pi.i >= pi.path.Ptr.num_data {
 		return nil
 	}
@@ -125,9 +383,135 @@ type SurfaceObserver struct {
 type ToyFontFace struct {
 	*FontFace
 }
+type FTFontFace struct {
+	*FontFace
+}
+
+// ToFTFontFace returns fontFace as a *FTFontFace, or fails with StatusFontTypeMismatch if it is another type of FontFace.
+func (fontFace *FontFace) ToFTFontFace() (*FTFontFace, error) {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if fontFace.GetType() != FontTypeFt {
+		return nil, StatusFontTypeMismatch
+	}
+	return &FTFontFace{fontFace}, nil
+}
+
+type FTScaledFont struct {
+	*ScaledFont
+}
+
+// ToFTScaledFont returns scaledFont as a *FTScaledFont, or fails with StatusFontTypeMismatch if it is another type of ScaledFont.
+func (scaledFont *ScaledFont) ToFTScaledFont() (*FTScaledFont, error) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if scaledFont.GetType() != FontTypeFt {
+		return nil, StatusFontTypeMismatch
+	}
+	return &FTScaledFont{scaledFont}, nil
+}
+
+type UserFontFace struct {
+	*FontFace
+}
 type MeshPattern struct {
 	*Pattern
 }
+
+// ToMeshPattern returns pattern as a *MeshPattern, or fails with StatusPatternTypeMismatch if it is another type of Pattern.
+func (pattern *Pattern) ToMeshPattern() (*MeshPattern, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.GetType() != PatternTypeMesh {
+		return nil, StatusPatternTypeMismatch
+	}
+	return &MeshPattern{pattern}, nil
+}
+
+type RasterSourcePattern struct {
+	*Pattern
+}
+
+// ToRasterSourcePattern returns pattern as a *RasterSourcePattern, or fails with StatusPatternTypeMismatch if it is another type of Pattern.
+func (pattern *Pattern) ToRasterSourcePattern() (*RasterSourcePattern, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.GetType() != PatternTypeRasterSource {
+		return nil, StatusPatternTypeMismatch
+	}
+	return &RasterSourcePattern{pattern}, nil
+}
+
+type LinearGradient struct {
+	*Pattern
+}
+
+// ToLinearGradient returns pattern as a *LinearGradient, or fails with StatusPatternTypeMismatch if it is another type of Pattern.
+func (pattern *Pattern) ToLinearGradient() (*LinearGradient, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.GetType() != PatternTypeLinear {
+		return nil, StatusPatternTypeMismatch
+	}
+	return &LinearGradient{pattern}, nil
+}
+
+type RadialGradient struct {
+	*Pattern
+}
+
+// ToRadialGradient returns pattern as a *RadialGradient, or fails with StatusPatternTypeMismatch if it is another type of Pattern.
+func (pattern *Pattern) ToRadialGradient() (*RadialGradient, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.GetType() != PatternTypeRadial {
+		return nil, StatusPatternTypeMismatch
+	}
+	return &RadialGradient{pattern}, nil
+}
+
+type SurfacePattern struct {
+	*Pattern
+}
+
+// ToSurfacePattern returns pattern as a *SurfacePattern, or fails with StatusPatternTypeMismatch if it is another type of Pattern.
+func (pattern *Pattern) ToSurfacePattern() (*SurfacePattern, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.GetType() != PatternTypeSurface {
+		return nil, StatusPatternTypeMismatch
+	}
+	return &SurfacePattern{pattern}, nil
+}
+
+type SolidPattern struct {
+	*Pattern
+}
+
+// ToSolidPattern returns pattern as a *SolidPattern, or fails with StatusPatternTypeMismatch if it is another type of Pattern.
+func (pattern *Pattern) ToSolidPattern() (*SolidPattern, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.GetType() != PatternTypeSolid {
+		return nil, StatusPatternTypeMismatch
+	}
+	return &SolidPattern{pattern}, nil
+}
+
+type PDFSurface struct {
+	*Surface
+}
+type PSSurface struct {
+	*Surface
+}
 type SVGSurface struct {
 	*Surface
 }
@@ -137,6 +521,15 @@ type XlibSurface struct {
 type XlibDevice struct {
 	*Device
 }
+type ScriptSurface struct {
+	*Surface
+}
+type ScriptDevice struct {
+	*Device
+}
+type TeeSurface struct {
+	*Surface
+}
 
 // See cairo_version().
 //
@@ -164,6 +557,16 @@ type Context struct {
 func freeContext(obj *Context) {
 	C.cairo_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowContext.
+func (obj *Context) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapContext(p *C.cairo_t) *Context {
 	ret := &Context{p}
 	runtime.SetFinalizer(ret, freeContext)
@@ -190,6 +593,16 @@ type Surface struct {
 func freeSurface(obj *Surface) {
 	C.cairo_surface_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_surface_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowSurface.
+func (obj *Surface) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_surface_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapSurface(p *C.cairo_surface_t) *Surface {
 	ret := &Surface{p}
 	runtime.SetFinalizer(ret, freeSurface)
@@ -216,6 +629,16 @@ type Device struct {
 func freeDevice(obj *Device) {
 	C.cairo_device_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_device_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowDevice.
+func (obj *Device) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_device_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapDevice(p *C.cairo_device_t) *Device {
 	ret := &Device{p}
 	runtime.SetFinalizer(ret, freeDevice)
@@ -254,6 +677,16 @@ type Pattern struct {
 func freePattern(obj *Pattern) {
 	C.cairo_pattern_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_pattern_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowPattern.
+func (obj *Pattern) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_pattern_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapPattern(p *C.cairo_pattern_t) *Pattern {
 	ret := &Pattern{p}
 	runtime.SetFinalizer(ret, freePattern)
@@ -380,10 +813,23 @@ func (i Format) String() string {
 	}
 }
 
+// See cairo_rectangle_int_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-Types.html#cairo-rectangle-int-t
+type RectangleInt struct {
+	X      int32
+	Y      int32
+	Width  int32
+	Height int32
+}
+
 // See cairo_create().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-create
 func Create(target *Surface) *Context {
+	if target.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapContext(C.cairo_create(target.Ptr))
 	if err := ret.status(); err != nil {
 		panic(err)
@@ -395,6 +841,9 @@ func Create(target *Surface) *Context {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-save
 func (cr *Context) Save() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_save(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -405,6 +854,9 @@ func (cr *Context) Save() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-restore
 func (cr *Context) Restore() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_restore(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -415,6 +867,9 @@ func (cr *Context) Restore() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-push-group
 func (cr *Context) PushGroup() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_push_group(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -425,6 +880,9 @@ func (cr *Context) PushGroup() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-push-group-with-content
 func (cr *Context) PushGroupWithContent(content Content) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_push_group_with_content(cr.Ptr, C.cairo_content_t(content))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -435,6 +893,9 @@ func (cr *Context) PushGroupWithContent(content Content) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-pop-group
 func (cr *Context) PopGroup() *Pattern {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapPattern(C.cairo_pop_group(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -446,6 +907,9 @@ func (cr *Context) PopGroup() *Pattern {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-pop-group-to-source
 func (cr *Context) PopGroupToSource() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pop_group_to_source(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -559,6 +1023,9 @@ func (i Operator) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-operator
 func (cr *Context) SetOperator(op Operator) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_operator(cr.Ptr, C.cairo_operator_t(op))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -569,6 +1036,12 @@ func (cr *Context) SetOperator(op Operator) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-source
 func (cr *Context) SetSource(source *Pattern) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if source.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_source(cr.Ptr, source.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -579,6 +1052,9 @@ func (cr *Context) SetSource(source *Pattern) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-source-rgb
 func (cr *Context) SetSourceRGB(red, green, blue float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_source_rgb(cr.Ptr, C.double(red), C.double(green), C.double(blue))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -589,6 +1065,9 @@ func (cr *Context) SetSourceRGB(red, green, blue float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-source-rgba
 func (cr *Context) SetSourceRGBA(red, green, blue, alpha float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_source_rgba(cr.Ptr, C.double(red), C.double(green), C.double(blue), C.double(alpha))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -599,6 +1078,12 @@ func (cr *Context) SetSourceRGBA(red, green, blue, alpha float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-source-surface
 func (cr *Context) SetSourceSurface(surface *Surface, x, y float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_source_surface(cr.Ptr, surface.Ptr, C.double(x), C.double(y))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -609,6 +1094,9 @@ func (cr *Context) SetSourceSurface(surface *Surface, x, y float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-tolerance
 func (cr *Context) SetTolerance(tolerance float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_tolerance(cr.Ptr, C.double(tolerance))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -656,6 +1144,9 @@ func (i Antialias) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-antialias
 func (cr *Context) SetAntialias(antialias Antialias) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_antialias(cr.Ptr, C.cairo_antialias_t(antialias))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -688,6 +1179,9 @@ func (i FillRule) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-fill-rule
 func (cr *Context) SetFillRule(fillRule FillRule) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_fill_rule(cr.Ptr, C.cairo_fill_rule_t(fillRule))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -698,6 +1192,9 @@ func (cr *Context) SetFillRule(fillRule FillRule) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-line-width
 func (cr *Context) SetLineWidth(width float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_line_width(cr.Ptr, C.double(width))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -733,6 +1230,9 @@ func (i LineCap) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-line-cap
 func (cr *Context) SetLineCap(lineCap LineCap) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_line_cap(cr.Ptr, C.cairo_line_cap_t(lineCap))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -768,6 +1268,9 @@ func (i LineJoin) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-line-join
 func (cr *Context) SetLineJoin(lineJoin LineJoin) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_line_join(cr.Ptr, C.cairo_line_join_t(lineJoin))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -778,6 +1281,9 @@ func (cr *Context) SetLineJoin(lineJoin LineJoin) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-dash
 func (cr *Context) SetDash(dashes []float64, offset float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_dash(cr.Ptr, (*C.double)(sliceBytes(unsafe.Pointer(&dashes))), C.int(len(dashes)), C.double(offset))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -788,6 +1294,9 @@ func (cr *Context) SetDash(dashes []float64, offset float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-set-miter-limit
 func (cr *Context) SetMiterLimit(limit float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_miter_limit(cr.Ptr, C.double(limit))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -798,6 +1307,9 @@ func (cr *Context) SetMiterLimit(limit float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-translate
 func (cr *Context) Translate(tx, ty float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_translate(cr.Ptr, C.double(tx), C.double(ty))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -808,6 +1320,9 @@ func (cr *Context) Translate(tx, ty float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-scale
 func (cr *Context) Scale(sx, sy float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scale(cr.Ptr, C.double(sx), C.double(sy))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -818,6 +1333,9 @@ func (cr *Context) Scale(sx, sy float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-rotate
 func (cr *Context) Rotate(angle float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_rotate(cr.Ptr, C.double(angle))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -828,6 +1346,9 @@ func (cr *Context) Rotate(angle float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-transform
 func (cr *Context) Transform(matrix *Matrix) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_transform(cr.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -838,6 +1359,9 @@ func (cr *Context) Transform(matrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-set-matrix
 func (cr *Context) SetMatrix(matrix *Matrix) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_matrix(cr.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -848,6 +1372,9 @@ func (cr *Context) SetMatrix(matrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-identity-matrix
 func (cr *Context) IdentityMatrix() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_identity_matrix(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -858,6 +1385,9 @@ func (cr *Context) IdentityMatrix() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-user-to-device
 func (cr *Context) UserToDevice(x, y *float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_user_to_device(cr.Ptr, (*C.double)(unsafe.Pointer(x)), (*C.double)(unsafe.Pointer(y)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -868,6 +1398,9 @@ func (cr *Context) UserToDevice(x, y *float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-user-to-device-distance
 func (cr *Context) UserToDeviceDistance(dx, dy *float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_user_to_device_distance(cr.Ptr, (*C.double)(unsafe.Pointer(dx)), (*C.double)(unsafe.Pointer(dy)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -878,6 +1411,9 @@ func (cr *Context) UserToDeviceDistance(dx, dy *float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-device-to-user
 func (cr *Context) DeviceToUser(x, y *float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_device_to_user(cr.Ptr, (*C.double)(unsafe.Pointer(x)), (*C.double)(unsafe.Pointer(y)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -888,6 +1424,9 @@ func (cr *Context) DeviceToUser(x, y *float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-device-to-user-distance
 func (cr *Context) DeviceToUserDistance(dx, dy *float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_device_to_user_distance(cr.Ptr, (*C.double)(unsafe.Pointer(dx)), (*C.double)(unsafe.Pointer(dy)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -898,6 +1437,9 @@ func (cr *Context) DeviceToUserDistance(dx, dy *float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-new-path
 func (cr *Context) NewPath() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_new_path(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -908,6 +1450,9 @@ func (cr *Context) NewPath() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-move-to
 func (cr *Context) MoveTo(x, y float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_move_to(cr.Ptr, C.double(x), C.double(y))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -918,6 +1463,9 @@ func (cr *Context) MoveTo(x, y float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-new-sub-path
 func (cr *Context) NewSubPath() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_new_sub_path(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -928,6 +1476,9 @@ func (cr *Context) NewSubPath() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-line-to
 func (cr *Context) LineTo(x, y float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_line_to(cr.Ptr, C.double(x), C.double(y))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -938,6 +1489,9 @@ func (cr *Context) LineTo(x, y float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-curve-to
 func (cr *Context) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_curve_to(cr.Ptr, C.double(x1), C.double(y1), C.double(x2), C.double(y2), C.double(x3), C.double(y3))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -948,6 +1502,9 @@ func (cr *Context) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-arc
 func (cr *Context) Arc(xc, yc, radius, angle1, angle2 float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_arc(cr.Ptr, C.double(xc), C.double(yc), C.double(radius), C.double(angle1), C.double(angle2))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -958,6 +1515,9 @@ func (cr *Context) Arc(xc, yc, radius, angle1, angle2 float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-arc-negative
 func (cr *Context) ArcNegative(xc, yc, radius, angle1, angle2 float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_arc_negative(cr.Ptr, C.double(xc), C.double(yc), C.double(radius), C.double(angle1), C.double(angle2))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -968,6 +1528,9 @@ func (cr *Context) ArcNegative(xc, yc, radius, angle1, angle2 float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-rel-move-to
 func (cr *Context) RelMoveTo(dx, dy float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_rel_move_to(cr.Ptr, C.double(dx), C.double(dy))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -978,6 +1541,9 @@ func (cr *Context) RelMoveTo(dx, dy float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-rel-line-to
 func (cr *Context) RelLineTo(dx, dy float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_rel_line_to(cr.Ptr, C.double(dx), C.double(dy))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -988,6 +1554,9 @@ func (cr *Context) RelLineTo(dx, dy float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-rel-curve-to
 func (cr *Context) RelCurveTo(dx1, dy1, dx2, dy2, dx3, dy3 float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_rel_curve_to(cr.Ptr, C.double(dx1), C.double(dy1), C.double(dx2), C.double(dy2), C.double(dx3), C.double(dy3))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -998,6 +1567,9 @@ func (cr *Context) RelCurveTo(dx1, dy1, dx2, dy2, dx3, dy3 float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-rectangle
 func (cr *Context) Rectangle(x, y, width, height float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_rectangle(cr.Ptr, C.double(x), C.double(y), C.double(width), C.double(height))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1008,6 +1580,9 @@ func (cr *Context) Rectangle(x, y, width, height float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-close-path
 func (cr *Context) ClosePath() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_close_path(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1018,6 +1593,9 @@ func (cr *Context) ClosePath() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-path-extents
 func (cr *Context) PathExtents() (float64, float64, float64, float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var x1 C.double
 	var y1 C.double
 	var x2 C.double
@@ -1034,6 +1612,9 @@ func (cr *Context) PathExtents() (float64, float64, float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-paint
 func (cr *Context) Paint() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_paint(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1044,6 +1625,9 @@ func (cr *Context) Paint() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-paint-with-alpha
 func (cr *Context) PaintWithAlpha(alpha float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_paint_with_alpha(cr.Ptr, C.double(alpha))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1054,6 +1638,12 @@ func (cr *Context) PaintWithAlpha(alpha float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-mask
 func (cr *Context) Mask(pattern *Pattern) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mask(cr.Ptr, pattern.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1064,6 +1654,12 @@ func (cr *Context) Mask(pattern *Pattern) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-mask-surface
 func (cr *Context) MaskSurface(surface *Surface, surfaceX, surfaceY float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mask_surface(cr.Ptr, surface.Ptr, C.double(surfaceX), C.double(surfaceY))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1074,6 +1670,9 @@ func (cr *Context) MaskSurface(surface *Surface, surfaceX, surfaceY float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-stroke
 func (cr *Context) Stroke() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_stroke(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1084,6 +1683,9 @@ func (cr *Context) Stroke() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-stroke-preserve
 func (cr *Context) StrokePreserve() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_stroke_preserve(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1094,6 +1696,9 @@ func (cr *Context) StrokePreserve() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-fill
 func (cr *Context) Fill() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_fill(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1104,6 +1709,9 @@ func (cr *Context) Fill() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-fill-preserve
 func (cr *Context) FillPreserve() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_fill_preserve(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1114,6 +1722,9 @@ func (cr *Context) FillPreserve() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-copy-page
 func (cr *Context) CopyPage() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_copy_page(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1124,6 +1735,9 @@ func (cr *Context) CopyPage() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-show-page
 func (cr *Context) ShowPage() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_show_page(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1134,6 +1748,9 @@ func (cr *Context) ShowPage() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-in-stroke
 func (cr *Context) InStroke(x, y float64) bool {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_in_stroke(cr.Ptr, C.double(x), C.double(y)) != 0
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1145,6 +1762,9 @@ func (cr *Context) InStroke(x, y float64) bool {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-in-fill
 func (cr *Context) InFill(x, y float64) bool {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_in_fill(cr.Ptr, C.double(x), C.double(y)) != 0
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1156,6 +1776,9 @@ func (cr *Context) InFill(x, y float64) bool {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-in-clip
 func (cr *Context) InClip(x, y float64) bool {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_in_clip(cr.Ptr, C.double(x), C.double(y)) != 0
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1167,6 +1790,9 @@ func (cr *Context) InClip(x, y float64) bool {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-stroke-extents
 func (cr *Context) StrokeExtents() (float64, float64, float64, float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var x1 C.double
 	var y1 C.double
 	var x2 C.double
@@ -1183,6 +1809,9 @@ func (cr *Context) StrokeExtents() (float64, float64, float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-fill-extents
 func (cr *Context) FillExtents() (float64, float64, float64, float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var x1 C.double
 	var y1 C.double
 	var x2 C.double
@@ -1199,6 +1828,9 @@ func (cr *Context) FillExtents() (float64, float64, float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-reset-clip
 func (cr *Context) ResetClip() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_reset_clip(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1209,6 +1841,9 @@ func (cr *Context) ResetClip() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-clip
 func (cr *Context) Clip() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_clip(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1219,6 +1854,9 @@ func (cr *Context) Clip() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-clip-preserve
 func (cr *Context) ClipPreserve() {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_clip_preserve(cr.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1229,6 +1867,9 @@ func (cr *Context) ClipPreserve() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-clip-extents
 func (cr *Context) ClipExtents() (float64, float64, float64, float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var x1 C.double
 	var y1 C.double
 	var x2 C.double
@@ -1251,6 +1892,65 @@ type Rectangle struct {
 	Height float64
 }
 
+// See cairo_copy_clip_rectangle_list().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-copy-clip-rectangle-list
+func (cr *Context) ClipRectangles() ([]Rectangle, error) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	list := C.cairo_copy_clip_rectangle_list(cr.Ptr)
+	defer C.cairo_rectangle_list_destroy(list)
+	if err := cr.status(); err != nil {
+		panic(err)
+	}
+	// This is StatusClipNotRepresentable if the clip isn't rectangles.
+	if err := Status(list.status).toError(); err != nil {
+		return nil, err
+	}
+	rects := make([]Rectangle, list.num_rectangles)
+	if len(rects) > 0 {
+		copy(rects, (*[1 << 30]Rectangle)(unsafe.Pointer(list.rectangles))[:len(rects):len(rects)])
+	}
+	return rects, nil
+}
+
+// See cairo_tag_begin().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Tags-and-Links.html#cairo-tag-begin
+func (cr *Context) TagBegin(tagName string, attrs TagAttributes) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	attributes := ""
+	if attrs != nil {
+		attributes = attrs.String()
+	}
+	c_tagName := C.CString(tagName)
+	defer C.free(unsafe.Pointer(c_tagName))
+	c_attributes := C.CString(attributes)
+	defer C.free(unsafe.Pointer(c_attributes))
+	C.cairo_tag_begin(cr.Ptr, c_tagName, c_attributes)
+	if err := cr.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_tag_end().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Tags-and-Links.html#cairo-tag-end
+func (cr *Context) TagEnd(tagName string) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_tagName := C.CString(tagName)
+	defer C.free(unsafe.Pointer(c_tagName))
+	C.cairo_tag_end(cr.Ptr, c_tagName)
+	if err := cr.status(); err != nil {
+		panic(err)
+	}
+}
+
 // See cairo_scaled_font_t.
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-t
@@ -1261,6 +1961,16 @@ type ScaledFont struct {
 func freeScaledFont(obj *ScaledFont) {
 	C.cairo_scaled_font_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_scaled_font_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowScaledFont.
+func (obj *ScaledFont) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_scaled_font_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapScaledFont(p *C.cairo_scaled_font_t) *ScaledFont {
 	ret := &ScaledFont{p}
 	runtime.SetFinalizer(ret, freeScaledFont)
@@ -1287,6 +1997,16 @@ type FontFace struct {
 func freeFontFace(obj *FontFace) {
 	C.cairo_font_face_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_font_face_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowFontFace.
+func (obj *FontFace) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_font_face_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapFontFace(p *C.cairo_font_face_t) *FontFace {
 	ret := &FontFace{p}
 	runtime.SetFinalizer(ret, freeFontFace)
@@ -1312,6 +2032,14 @@ type Glyph struct {
 	Y     float64
 }
 
+// See cairo_text_cluster_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-text-cluster-t
+type TextCluster struct {
+	NumBytes  int32
+	NumGlyphs int32
+}
+
 // See cairo_text_cluster_flags_t.
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-text-cluster-flags-t
@@ -1498,6 +2226,16 @@ type FontOptions struct {
 func freeFontOptions(obj *FontOptions) {
 	C.cairo_font_options_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_font_options_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowFontOptions.
+func (obj *FontOptions) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_font_options_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapFontOptions(p *C.cairo_font_options_t) *FontOptions {
 	ret := &FontOptions{p}
 	runtime.SetFinalizer(ret, freeFontOptions)
@@ -1529,6 +2267,9 @@ func FontOptionsCreate() *FontOptions {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-copy
 func (original *FontOptions) Copy() *FontOptions {
+	if original.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapFontOptions(C.cairo_font_options_copy(original.Ptr))
 	if err := original.status(); err != nil {
 		panic(err)
@@ -1548,6 +2289,12 @@ func (options *FontOptions) status() error {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-merge
 func (options *FontOptions) Merge(other *FontOptions) {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_font_options_merge(options.Ptr, other.Ptr)
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1558,6 +2305,12 @@ func (options *FontOptions) Merge(other *FontOptions) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-equal
 func (options *FontOptions) Equal(other *FontOptions) bool {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_font_options_equal(options.Ptr, other.Ptr) != 0
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1569,6 +2322,9 @@ func (options *FontOptions) Equal(other *FontOptions) bool {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-hash
 func (options *FontOptions) Hash() uint32 {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := uint32(C.cairo_font_options_hash(options.Ptr))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1580,6 +2336,9 @@ func (options *FontOptions) Hash() uint32 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-set-antialias
 func (options *FontOptions) SetAntialias(antialias Antialias) {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_font_options_set_antialias(options.Ptr, C.cairo_antialias_t(antialias))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1590,6 +2349,9 @@ func (options *FontOptions) SetAntialias(antialias Antialias) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-get-antialias
 func (options *FontOptions) GetAntialias() Antialias {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Antialias(C.cairo_font_options_get_antialias(options.Ptr))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1601,6 +2363,9 @@ func (options *FontOptions) GetAntialias() Antialias {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-set-subpixel-order
 func (options *FontOptions) SetSubpixelOrder(subpixelOrder SubpixelOrder) {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_font_options_set_subpixel_order(options.Ptr, C.cairo_subpixel_order_t(subpixelOrder))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1611,6 +2376,9 @@ func (options *FontOptions) SetSubpixelOrder(subpixelOrder SubpixelOrder) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-get-subpixel-order
 func (options *FontOptions) GetSubpixelOrder() SubpixelOrder {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := SubpixelOrder(C.cairo_font_options_get_subpixel_order(options.Ptr))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1622,6 +2390,9 @@ func (options *FontOptions) GetSubpixelOrder() SubpixelOrder {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-set-hint-style
 func (options *FontOptions) SetHintStyle(hintStyle HintStyle) {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_font_options_set_hint_style(options.Ptr, C.cairo_hint_style_t(hintStyle))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1632,6 +2403,9 @@ func (options *FontOptions) SetHintStyle(hintStyle HintStyle) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-get-hint-style
 func (options *FontOptions) GetHintStyle() HintStyle {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := HintStyle(C.cairo_font_options_get_hint_style(options.Ptr))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1643,6 +2417,9 @@ func (options *FontOptions) GetHintStyle() HintStyle {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-set-hint-metrics
 func (options *FontOptions) SetHintMetrics(hintMetrics HintMetrics) {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_font_options_set_hint_metrics(options.Ptr, C.cairo_hint_metrics_t(hintMetrics))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1653,6 +2430,9 @@ func (options *FontOptions) SetHintMetrics(hintMetrics HintMetrics) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-options-t.html#cairo-font-options-get-hint-metrics
 func (options *FontOptions) GetHintMetrics() HintMetrics {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := HintMetrics(C.cairo_font_options_get_hint_metrics(options.Ptr))
 	if err := options.status(); err != nil {
 		panic(err)
@@ -1664,6 +2444,9 @@ func (options *FontOptions) GetHintMetrics() HintMetrics {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-select-font-face
 func (cr *Context) SelectFontFace(family string, slant FontSlant, weight FontWeight) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	c_family := C.CString(family)
 	defer C.free(unsafe.Pointer(c_family))
 	C.cairo_select_font_face(cr.Ptr, c_family, C.cairo_font_slant_t(slant), C.cairo_font_weight_t(weight))
@@ -1676,6 +2459,9 @@ func (cr *Context) SelectFontFace(family string, slant FontSlant, weight FontWei
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-set-font-size
 func (cr *Context) SetFontSize(size float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_font_size(cr.Ptr, C.double(size))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1686,6 +2472,9 @@ func (cr *Context) SetFontSize(size float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-set-font-matrix
 func (cr *Context) SetFontMatrix(matrix *Matrix) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_font_matrix(cr.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1696,6 +2485,9 @@ func (cr *Context) SetFontMatrix(matrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-get-font-matrix
 func (cr *Context) GetFontMatrix(matrix *Matrix) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_get_font_matrix(cr.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1706,6 +2498,12 @@ func (cr *Context) GetFontMatrix(matrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-set-font-options
 func (cr *Context) SetFontOptions(options *FontOptions) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_font_options(cr.Ptr, options.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1716,6 +2514,12 @@ func (cr *Context) SetFontOptions(options *FontOptions) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-get-font-options
 func (cr *Context) GetFontOptions(options *FontOptions) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_get_font_options(cr.Ptr, options.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1726,6 +2530,12 @@ func (cr *Context) GetFontOptions(options *FontOptions) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-set-font-face
 func (cr *Context) SetFontFace(fontFace *FontFace) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_font_face(cr.Ptr, fontFace.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1736,7 +2546,10 @@ func (cr *Context) SetFontFace(fontFace *FontFace) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-get-font-face
 func (cr *Context) GetFontFace() *FontFace {
-	ret := wrapFontFace(C.cairo_get_font_face(cr.Ptr))
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapFontFace(C.cairo_font_face_reference(C.cairo_get_font_face(cr.Ptr)))
 	if err := cr.status(); err != nil {
 		panic(err)
 	}
@@ -1747,6 +2560,12 @@ func (cr *Context) GetFontFace() *FontFace {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-set-scaled-font
 func (cr *Context) SetScaledFont(scaledFont *ScaledFont) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_set_scaled_font(cr.Ptr, scaledFont.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1757,7 +2576,10 @@ func (cr *Context) SetScaledFont(scaledFont *ScaledFont) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-get-scaled-font
 func (cr *Context) GetScaledFont() *ScaledFont {
-	ret := wrapScaledFont(C.cairo_get_scaled_font(cr.Ptr))
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapScaledFont(C.cairo_scaled_font_reference(C.cairo_get_scaled_font(cr.Ptr)))
 	if err := cr.status(); err != nil {
 		panic(err)
 	}
@@ -1768,6 +2590,9 @@ func (cr *Context) GetScaledFont() *ScaledFont {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-show-text
 func (cr *Context) ShowText(utf8 string) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	c_utf8 := C.CString(utf8)
 	defer C.free(unsafe.Pointer(c_utf8))
 	C.cairo_show_text(cr.Ptr, c_utf8)
@@ -1780,16 +2605,37 @@ func (cr *Context) ShowText(utf8 string) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-show-glyphs
 func (cr *Context) ShowGlyphs(glyphs []Glyph) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_show_glyphs(cr.Ptr, (*C.cairo_glyph_t)(sliceBytes(unsafe.Pointer(&glyphs))), C.int(len(glyphs)))
 	if err := cr.status(); err != nil {
 		panic(err)
 	}
 }
 
+// See cairo_show_text_glyphs().
+//
+// C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-show-text-glyphs
+func (cr *Context) ShowTextGlyphs(utf8 string, glyphs []Glyph, clusters []TextCluster, clusterFlags TextClusterFlags) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_utf8 := C.CString(utf8)
+	defer C.free(unsafe.Pointer(c_utf8))
+	C.cairo_show_text_glyphs(cr.Ptr, c_utf8, C.int(len(utf8)), (*C.cairo_glyph_t)(sliceBytes(unsafe.Pointer(&glyphs))), C.int(len(glyphs)), (*C.cairo_text_cluster_t)(sliceBytes(unsafe.Pointer(&clusters))), C.int(len(clusters)), C.cairo_text_cluster_flags_t(clusterFlags))
+	if err := cr.status(); err != nil {
+		panic(err)
+	}
+}
+
 // See cairo_text_path().
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-text-path
 func (cr *Context) TextPath(utf8 string) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	c_utf8 := C.CString(utf8)
 	defer C.free(unsafe.Pointer(c_utf8))
 	C.cairo_text_path(cr.Ptr, c_utf8)
@@ -1802,6 +2648,9 @@ func (cr *Context) TextPath(utf8 string) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-glyph-path
 func (cr *Context) GlyphPath(glyphs []Glyph) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_glyph_path(cr.Ptr, (*C.cairo_glyph_t)(sliceBytes(unsafe.Pointer(&glyphs))), C.int(len(glyphs)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1812,6 +2661,9 @@ func (cr *Context) GlyphPath(glyphs []Glyph) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-text-extents
 func (cr *Context) TextExtents(utf8 string, extents *TextExtents) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	c_utf8 := C.CString(utf8)
 	defer C.free(unsafe.Pointer(c_utf8))
 	C.cairo_text_extents(cr.Ptr, c_utf8, (*C.cairo_text_extents_t)(unsafe.Pointer(extents)))
@@ -1824,6 +2676,9 @@ func (cr *Context) TextExtents(utf8 string, extents *TextExtents) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-glyph-extents
 func (cr *Context) GlyphExtents(glyphs []Glyph, extents *TextExtents) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_glyph_extents(cr.Ptr, (*C.cairo_glyph_t)(sliceBytes(unsafe.Pointer(&glyphs))), C.int(len(glyphs)), (*C.cairo_text_extents_t)(unsafe.Pointer(extents)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1834,6 +2689,9 @@ func (cr *Context) GlyphExtents(glyphs []Glyph, extents *TextExtents) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-font-extents
 func (cr *Context) FontExtents(extents *FontExtents) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_font_extents(cr.Ptr, (*C.cairo_font_extents_t)(unsafe.Pointer(extents)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -1883,6 +2741,9 @@ func (i FontType) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-font-face-t.html#cairo-font-face-get-type
 func (fontFace *FontFace) GetType() FontType {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := FontType(C.cairo_font_face_get_type(fontFace.Ptr))
 	if err := fontFace.status(); err != nil {
 		panic(err)
@@ -1894,6 +2755,12 @@ func (fontFace *FontFace) GetType() FontType {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-create
 func ScaledFontCreate(fontFace *FontFace, fontMatrix, ctm *Matrix, options *FontOptions) *ScaledFont {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapScaledFont(C.cairo_scaled_font_create(fontFace.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(fontMatrix)), (*C.cairo_matrix_t)(unsafe.Pointer(ctm)), options.Ptr))
 	if err := ret.status(); err != nil {
 		panic(err)
@@ -1913,6 +2780,9 @@ func (scaledFont *ScaledFont) status() error {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-get-type
 func (scaledFont *ScaledFont) GetType() FontType {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := FontType(C.cairo_scaled_font_get_type(scaledFont.Ptr))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
@@ -1924,6 +2794,9 @@ func (scaledFont *ScaledFont) GetType() FontType {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-extents
 func (scaledFont *ScaledFont) Extents(extents *FontExtents) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scaled_font_extents(scaledFont.Ptr, (*C.cairo_font_extents_t)(unsafe.Pointer(extents)))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
@@ -1934,6 +2807,9 @@ func (scaledFont *ScaledFont) Extents(extents *FontExtents) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-text-extents
 func (scaledFont *ScaledFont) TextExtents(utf8 string, extents *TextExtents) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	c_utf8 := C.CString(utf8)
 	defer C.free(unsafe.Pointer(c_utf8))
 	C.cairo_scaled_font_text_extents(scaledFont.Ptr, c_utf8, (*C.cairo_text_extents_t)(unsafe.Pointer(extents)))
@@ -1946,17 +2822,57 @@ func (scaledFont *ScaledFont) TextExtents(utf8 string, extents *TextExtents) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-glyph-extents
 func (scaledFont *ScaledFont) GlyphExtents(glyphs []Glyph, extents *TextExtents) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scaled_font_glyph_extents(scaledFont.Ptr, (*C.cairo_glyph_t)(sliceBytes(unsafe.Pointer(&glyphs))), C.int(len(glyphs)), (*C.cairo_text_extents_t)(unsafe.Pointer(extents)))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
 	}
 }
 
+// See cairo_scaled_font_text_to_glyphs().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-text-to-glyphs
+func (scaledFont *ScaledFont) TextToGlyphs(x, y float64, utf8 string) ([]Glyph, []TextCluster, TextClusterFlags, error) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_utf8 := C.CString(utf8)
+	defer C.free(unsafe.Pointer(c_utf8))
+	var cGlyphs *C.cairo_glyph_t
+	var cNumGlyphs C.int
+	var cClusters *C.cairo_text_cluster_t
+	var cNumClusters C.int
+	var cClusterFlags C.cairo_text_cluster_flags_t
+	status := C.cairo_scaled_font_text_to_glyphs(scaledFont.Ptr, C.double(x), C.double(y), c_utf8, C.int(len(utf8)), &cGlyphs, &cNumGlyphs, &cClusters, &cNumClusters, &cClusterFlags)
+	if err := Status(status).toError(); err != nil {
+		return nil, nil, 0, err
+	}
+	defer C.cairo_glyph_free(cGlyphs)
+	defer C.cairo_text_cluster_free(cClusters)
+	glyphs := make([]Glyph, cNumGlyphs)
+	if cNumGlyphs > 0 {
+		slice := (*[1 << 30]C.cairo_glyph_t)(unsafe.Pointer(cGlyphs))[:cNumGlyphs:cNumGlyphs]
+		for i, g := range slice {
+			glyphs[i] = Glyph{Index: uint32(g.index), X: float64(g.x), Y: float64(g.y)}
+		}
+	}
+	clusters := make([]TextCluster, cNumClusters)
+	if cNumClusters > 0 {
+		copy(clusters, (*[1 << 30]TextCluster)(unsafe.Pointer(cClusters))[:cNumClusters:cNumClusters])
+	}
+	return glyphs, clusters, TextClusterFlags(cClusterFlags), nil
+}
+
 // See cairo_scaled_font_get_font_face().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-get-font-face
 func (scaledFont *ScaledFont) GetFontFace() *FontFace {
-	ret := wrapFontFace(C.cairo_scaled_font_get_font_face(scaledFont.Ptr))
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapFontFace(C.cairo_font_face_reference(C.cairo_scaled_font_get_font_face(scaledFont.Ptr)))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
 	}
@@ -1967,6 +2883,9 @@ func (scaledFont *ScaledFont) GetFontFace() *FontFace {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-get-font-matrix
 func (scaledFont *ScaledFont) GetFontMatrix(fontMatrix *Matrix) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scaled_font_get_font_matrix(scaledFont.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(fontMatrix)))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
@@ -1977,6 +2896,9 @@ func (scaledFont *ScaledFont) GetFontMatrix(fontMatrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-get-ctm
 func (scaledFont *ScaledFont) GetCTM(ctm *Matrix) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scaled_font_get_ctm(scaledFont.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(ctm)))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
@@ -1987,6 +2909,9 @@ func (scaledFont *ScaledFont) GetCTM(ctm *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-get-scale-matrix
 func (scaledFont *ScaledFont) GetScaleMatrix(scaleMatrix *Matrix) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scaled_font_get_scale_matrix(scaledFont.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(scaleMatrix)))
 	if err := scaledFont.status(); err != nil {
 		panic(err)
@@ -1997,6 +2922,12 @@ func (scaledFont *ScaledFont) GetScaleMatrix(scaleMatrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-scaled-font-t.html#cairo-scaled-font-get-font-options
 func (scaledFont *ScaledFont) GetFontOptions(options *FontOptions) {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_scaled_font_get_font_options(scaledFont.Ptr, options.Ptr)
 	if err := scaledFont.status(); err != nil {
 		panic(err)
@@ -2020,6 +2951,9 @@ func ToyFontFaceCreate(family string, slant FontSlant, weight FontWeight) *ToyFo
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-toy-font-face-get-family
 func (fontFace *ToyFontFace) GetFamily() string {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.GoString(C.cairo_toy_font_face_get_family(fontFace.Ptr))
 	if err := fontFace.status(); err != nil {
 		panic(err)
@@ -2031,6 +2965,9 @@ func (fontFace *ToyFontFace) GetFamily() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-toy-font-face-get-slant
 func (fontFace *ToyFontFace) GetSlant() FontSlant {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := FontSlant(C.cairo_toy_font_face_get_slant(fontFace.Ptr))
 	if err := fontFace.status(); err != nil {
 		panic(err)
@@ -2042,6 +2979,9 @@ func (fontFace *ToyFontFace) GetSlant() FontSlant {
 //
 // C API documentation: http://cairographics.org/manual/cairo-text.html#cairo-toy-font-face-get-weight
 func (fontFace *ToyFontFace) GetWeight() FontWeight {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := FontWeight(C.cairo_toy_font_face_get_weight(fontFace.Ptr))
 	if err := fontFace.status(); err != nil {
 		panic(err)
@@ -2052,8 +2992,20 @@ func (fontFace *ToyFontFace) GetWeight() FontWeight {
 // See cairo_user_font_face_create().
 //
 // C API documentation: http://cairographics.org/manual/cairo-User-Fonts.html#cairo-user-font-face-create
-func UserFontFaceCreate() *FontFace {
-	ret := wrapFontFace(C.cairo_user_font_face_create())
+func UserFontFaceCreate(font UserFont) *UserFontFace {
+	p := C.cairo_user_font_face_create()
+	key := newKey(font)
+	status := C.cairo_font_face_set_user_data(p, &C.gocairo_user_font_key,
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		// Error font faces don't hold user data, so free it now.
+		C.gocairo_free_key(unsafe.Pointer(key))
+	}
+	C.cairo_user_font_face_set_init_func(p, (C.cairo_user_scaled_font_init_func_t)(unsafe.Pointer(C.gocairo_user_font_init)))
+	C.cairo_user_font_face_set_render_glyph_func(p, (C.cairo_user_scaled_font_render_glyph_func_t)(unsafe.Pointer(C.gocairo_user_font_render_glyph)))
+	C.cairo_user_font_face_set_text_to_glyphs_func(p, (C.cairo_user_scaled_font_text_to_glyphs_func_t)(unsafe.Pointer(C.gocairo_user_font_text_to_glyphs)))
+	C.cairo_user_font_face_set_unicode_to_glyph_func(p, (C.cairo_user_scaled_font_unicode_to_glyph_func_t)(unsafe.Pointer(C.gocairo_user_font_unicode_to_glyph)))
+	ret := &UserFontFace{wrapFontFace(p)}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -2064,6 +3016,9 @@ func UserFontFaceCreate() *FontFace {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-operator
 func (cr *Context) GetOperator() Operator {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Operator(C.cairo_get_operator(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2075,7 +3030,10 @@ func (cr *Context) GetOperator() Operator {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-source
 func (cr *Context) GetSource() *Pattern {
-	ret := wrapPattern(C.cairo_get_source(cr.Ptr))
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapPattern(C.cairo_pattern_reference(C.cairo_get_source(cr.Ptr)))
 	if err := cr.status(); err != nil {
 		panic(err)
 	}
@@ -2086,6 +3044,9 @@ func (cr *Context) GetSource() *Pattern {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-tolerance
 func (cr *Context) GetTolerance() float64 {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_get_tolerance(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2097,6 +3058,9 @@ func (cr *Context) GetTolerance() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-antialias
 func (cr *Context) GetAntialias() Antialias {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Antialias(C.cairo_get_antialias(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2108,6 +3072,9 @@ func (cr *Context) GetAntialias() Antialias {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-has-current-point
 func (cr *Context) HasCurrentPoint() bool {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_has_current_point(cr.Ptr) != 0
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2119,6 +3086,9 @@ func (cr *Context) HasCurrentPoint() bool {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-get-current-point
 func (cr *Context) GetCurrentPoint() (float64, float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var x C.double
 	var y C.double
 
@@ -2133,6 +3103,9 @@ func (cr *Context) GetCurrentPoint() (float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-fill-rule
 func (cr *Context) GetFillRule() FillRule {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := FillRule(C.cairo_get_fill_rule(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2144,6 +3117,9 @@ func (cr *Context) GetFillRule() FillRule {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-line-width
 func (cr *Context) GetLineWidth() float64 {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_get_line_width(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2155,6 +3131,9 @@ func (cr *Context) GetLineWidth() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-line-cap
 func (cr *Context) GetLineCap() LineCap {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := LineCap(C.cairo_get_line_cap(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2166,6 +3145,9 @@ func (cr *Context) GetLineCap() LineCap {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-line-join
 func (cr *Context) GetLineJoin() LineJoin {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := LineJoin(C.cairo_get_line_join(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2177,6 +3159,9 @@ func (cr *Context) GetLineJoin() LineJoin {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-miter-limit
 func (cr *Context) GetMiterLimit() float64 {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_get_miter_limit(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2188,6 +3173,9 @@ func (cr *Context) GetMiterLimit() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-dash-count
 func (cr *Context) GetDashCount() int {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_get_dash_count(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2199,6 +3187,9 @@ func (cr *Context) GetDashCount() int {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-dash
 func (cr *Context) GetDash(dashes, offset *float64) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_get_dash(cr.Ptr, (*C.double)(unsafe.Pointer(dashes)), (*C.double)(unsafe.Pointer(offset)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2209,6 +3200,9 @@ func (cr *Context) GetDash(dashes, offset *float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Transformations.html#cairo-get-matrix
 func (cr *Context) GetMatrix(matrix *Matrix) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_get_matrix(cr.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2219,7 +3213,10 @@ func (cr *Context) GetMatrix(matrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-target
 func (cr *Context) GetTarget() *Surface {
-	ret := wrapSurface(C.cairo_get_target(cr.Ptr))
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapSurface(C.cairo_surface_reference(C.cairo_get_target(cr.Ptr)))
 	if err := cr.status(); err != nil {
 		panic(err)
 	}
@@ -2230,7 +3227,10 @@ func (cr *Context) GetTarget() *Surface {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-t.html#cairo-get-group-target
 func (cr *Context) GetGroupTarget() *Surface {
-	ret := wrapSurface(C.cairo_get_group_target(cr.Ptr))
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapSurface(C.cairo_surface_reference(C.cairo_get_group_target(cr.Ptr)))
 	if err := cr.status(); err != nil {
 		panic(err)
 	}
@@ -2275,6 +3275,16 @@ type Path struct {
 func freePath(obj *Path) {
 	C.cairo_path_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_path_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowPath.
+func (obj *Path) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_path_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapPath(p *C.cairo_path_t) *Path {
 	ret := &Path{p}
 	runtime.SetFinalizer(ret, freePath)
@@ -2295,6 +3305,9 @@ func BorrowPath(p unsafe.Pointer) *Path {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-copy-path
 func (cr *Context) CopyPath() *Path {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapPath(C.cairo_copy_path(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2306,6 +3319,9 @@ func (cr *Context) CopyPath() *Path {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-copy-path-flat
 func (cr *Context) CopyPathFlat() *Path {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapPath(C.cairo_copy_path_flat(cr.Ptr))
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2317,6 +3333,12 @@ func (cr *Context) CopyPathFlat() *Path {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Paths.html#cairo-append-path
 func (cr *Context) AppendPath(path *Path) {
+	if cr.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if path.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_append_path(cr.Ptr, path.Ptr)
 	if err := cr.status(); err != nil {
 		panic(err)
@@ -2378,6 +3400,9 @@ func (i DeviceType) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-get-type
 func (device *Device) GetType() DeviceType {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := DeviceType(C.cairo_device_get_type(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2397,6 +3422,9 @@ func (device *Device) status() error {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-acquire
 func (device *Device) Acquire() error {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Status(C.cairo_device_acquire(device.Ptr)).toError()
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2408,6 +3436,9 @@ func (device *Device) Acquire() error {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-release
 func (device *Device) Release() {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_device_release(device.Ptr)
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2418,6 +3449,9 @@ func (device *Device) Release() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-flush
 func (device *Device) Flush() {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_device_flush(device.Ptr)
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2428,6 +3462,9 @@ func (device *Device) Flush() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-finish
 func (device *Device) Finish() {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_device_finish(device.Ptr)
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2438,6 +3475,9 @@ func (device *Device) Finish() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-create-similar
 func (other *Surface) CreateSimilar(content Content, width, height int) *Surface {
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapSurface(C.cairo_surface_create_similar(other.Ptr, C.cairo_content_t(content), C.int(width), C.int(height)))
 	if err := other.status(); err != nil {
 		panic(err)
@@ -2448,28 +3488,107 @@ func (other *Surface) CreateSimilar(content Content, width, height int) *Surface
 // See cairo_surface_create_similar_image().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-create-similar-image
-func (other *Surface) CreateSimilarImage(format Format, width, height int) *Surface {
-	ret := wrapSurface(C.cairo_surface_create_similar_image(other.Ptr, C.cairo_format_t(format), C.int(width), C.int(height)))
+func (other *Surface) CreateSimilarImage(format Format, width, height int) *ImageSurface {
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := &ImageSurface{wrapSurface(C.cairo_surface_create_similar_image(other.Ptr, C.cairo_format_t(format), C.int(width), C.int(height)))}
 	if err := other.status(); err != nil {
 		panic(err)
 	}
 	return ret
 }
 
+// See cairo_surface_map_to_image().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-map-to-image
+//
+// The image must be released with UnmapImage or its Close method, not
+// with ImageSurface.Close.
+func (surface *Surface) MapToImage(rect *image.Rectangle) *MappedImage {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var extents *C.cairo_rectangle_int_t
+	if rect != nil {
+		r := RectangleIntFromImage(*rect)
+		extents = (*C.cairo_rectangle_int_t)(unsafe.Pointer(&r))
+	}
+	p := C.cairo_surface_map_to_image(surface.Ptr, extents)
+	// The image belongs to surface until UnmapImage, so it gets no
+	// finalizer.
+	ret := &MappedImage{&ImageSurface{&Surface{p}}, surface}
+	if err := ret.status(); err != nil {
+		C.cairo_surface_destroy(p)
+		panic(err)
+	}
+	return ret
+}
+
+// MappedImage is an image of part of another surface, from
+// Surface.MapToImage.  It belongs to that surface: release it with
+// UnmapImage or Close, which write any changes back, and never Close
+// the embedded ImageSurface, which would destroy the surface's own copy.
+type MappedImage struct {
+	*ImageSurface
+	// target is the surface the image maps.
+	target *Surface
+}
+
+// Close unmaps the image from the surface it maps, as UnmapImage does,
+// returning the surface's error if any.  It is safe to call Close more
+// than once.
+func (image *MappedImage) Close() (err error) {
+	if image.Ptr == nil {
+		return nil
+	}
+	defer catchStatus(&err)
+	image.target.UnmapImage(image)
+	return nil
+}
+
 // See cairo_surface_unmap_image().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-unmap-image
-func (surface *Surface) UnmapImage(image *Surface) {
+func (surface *Surface) UnmapImage(image *MappedImage) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if image.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_unmap_image(surface.Ptr, image.Ptr)
+	// cairo destroyed the image.
+	image.Ptr = nil
 	if err := surface.status(); err != nil {
 		panic(err)
 	}
 }
 
+// WithMappedImage maps rect of surface, or all of it if rect is nil, to
+// an image for f to read or modify, and unmaps it once f returns.  img
+// is only valid during the call.
+func (surface *Surface) WithMappedImage(rect *image.Rectangle, f func(img *MappedImage)) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	img := surface.MapToImage(rect)
+	defer func() {
+		// f may have closed img itself.
+		if img.Ptr != nil {
+			surface.UnmapImage(img)
+		}
+	}()
+	f(img)
+}
+
 // See cairo_surface_create_for_rectangle().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-create-for-rectangle
 func (target *Surface) CreateForRectangle(x, y, width, height float64) *Surface {
+	if target.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := wrapSurface(C.cairo_surface_create_for_rectangle(target.Ptr, C.double(x), C.double(y), C.double(width), C.double(height)))
 	if err := target.status(); err != nil {
 		panic(err)
@@ -2499,6 +3618,9 @@ func (i SurfaceObserverMode) String() string {
 
 // See cairo_surface_create_observer().
 func (target *Surface) CreateObserver(mode SurfaceObserverMode) *SurfaceObserver {
+	if target.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := &SurfaceObserver{wrapSurface(C.cairo_surface_create_observer(target.Ptr, C.cairo_surface_observer_mode_t(mode)))}
 	if err := target.status(); err != nil {
 		panic(err)
@@ -2506,8 +3628,114 @@ func (target *Surface) CreateObserver(mode SurfaceObserverMode) *SurfaceObserver
 	return ret
 }
 
+// See cairo_surface_observer_add_paint_callback().
+func (surface *SurfaceObserver) AddPaintCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_paint_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_add_mask_callback().
+func (surface *SurfaceObserver) AddMaskCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_mask_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_add_fill_callback().
+func (surface *SurfaceObserver) AddFillCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_fill_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_add_stroke_callback().
+func (surface *SurfaceObserver) AddStrokeCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_stroke_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_add_glyphs_callback().
+func (surface *SurfaceObserver) AddGlyphsCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_glyphs_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_add_flush_callback().
+func (surface *SurfaceObserver) AddFlushCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_flush_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_add_finish_callback().
+func (surface *SurfaceObserver) AddFinishCallback(f SurfaceObserverCallback) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := newObserverKey(surface.Ptr, f)
+	status := C.cairo_surface_observer_add_finish_callback(surface.Ptr,
+		(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+	if err := Status(status).toError(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_surface_observer_print().
+func (surface *SurfaceObserver) Print(w io.Writer) error {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := goPointers.put(writeClosure{w: w})
+	status := C.cairo_surface_observer_print(surface.Ptr,
+		(C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(&key))
+	goPointers.clear(key)
+	return Status(status).toError()
+}
+
 // See cairo_surface_observer_elapsed().
 func (surface *SurfaceObserver) Elapsed() float64 {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_surface_observer_elapsed(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2515,10 +3743,27 @@ func (surface *SurfaceObserver) Elapsed() float64 {
 	return ret
 }
 
+// See cairo_device_observer_print().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-print
+func (device *Device) ObserverPrint(w io.Writer) error {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	key := goPointers.put(writeClosure{w: w})
+	status := C.cairo_device_observer_print(device.Ptr,
+		(C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(&key))
+	goPointers.clear(key)
+	return Status(status).toError()
+}
+
 // See cairo_device_observer_elapsed().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-elapsed
 func (device *Device) ObserverElapsed() float64 {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_device_observer_elapsed(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2530,6 +3775,9 @@ func (device *Device) ObserverElapsed() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-paint-elapsed
 func (device *Device) ObserverPaintElapsed() float64 {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_device_observer_paint_elapsed(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2541,6 +3789,9 @@ func (device *Device) ObserverPaintElapsed() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-mask-elapsed
 func (device *Device) ObserverMaskElapsed() float64 {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_device_observer_mask_elapsed(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2552,6 +3803,9 @@ func (device *Device) ObserverMaskElapsed() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-fill-elapsed
 func (device *Device) ObserverFillElapsed() float64 {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_device_observer_fill_elapsed(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2563,6 +3817,9 @@ func (device *Device) ObserverFillElapsed() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-stroke-elapsed
 func (device *Device) ObserverStrokeElapsed() float64 {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_device_observer_stroke_elapsed(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2574,6 +3831,9 @@ func (device *Device) ObserverStrokeElapsed() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-device-t.html#cairo-device-observer-glyphs-elapsed
 func (device *Device) ObserverGlyphsElapsed() float64 {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := float64(C.cairo_device_observer_glyphs_elapsed(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -2585,6 +3845,9 @@ func (device *Device) ObserverGlyphsElapsed() float64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-finish
 func (surface *Surface) Finish() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_finish(surface.Ptr)
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2595,7 +3858,10 @@ func (surface *Surface) Finish() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-device
 func (surface *Surface) GetDevice() *Device {
-	ret := wrapDevice(C.cairo_surface_get_device(surface.Ptr))
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapDevice(C.cairo_device_reference(C.cairo_surface_get_device(surface.Ptr)))
 	if err := surface.status(); err != nil {
 		panic(err)
 	}
@@ -2705,6 +3971,9 @@ func (i SurfaceType) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-type
 func (surface *Surface) GetType() SurfaceType {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := SurfaceType(C.cairo_surface_get_type(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2716,6 +3985,9 @@ func (surface *Surface) GetType() SurfaceType {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-content
 func (surface *Surface) GetContent() Content {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Content(C.cairo_surface_get_content(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2723,10 +3995,59 @@ func (surface *Surface) GetContent() Content {
 	return ret
 }
 
+// See cairo_surface_get_mime_data().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-mime-data
+func (surface *Surface) MIMEData(mimeType string) []byte {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_mimeType := C.CString(mimeType)
+	defer C.free(unsafe.Pointer(c_mimeType))
+	var data *C.uchar
+	var length C.ulong
+	C.cairo_surface_get_mime_data(surface.Ptr, c_mimeType, &data, &length)
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+	if data == nil {
+		return nil
+	}
+	return C.GoBytes(unsafe.Pointer(data), C.int(length))
+}
+
+// See cairo_surface_set_mime_data().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-set-mime-data
+func (surface *Surface) SetMIMEData(mimeType string, data []byte) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_mimeType := C.CString(mimeType)
+	defer C.free(unsafe.Pointer(c_mimeType))
+	// cairo holds on to the data, so it gets a copy in C memory that it
+	// frees once it's done with it.  nil data removes the MIME data.
+	var c_data unsafe.Pointer
+	var destroy C.cairo_destroy_func_t
+	if data != nil {
+		c_data = C.CBytes(data)
+		destroy = (C.cairo_destroy_func_t)(unsafe.Pointer(C.free))
+	}
+	status := C.cairo_surface_set_mime_data(surface.Ptr, c_mimeType, (*C.uchar)(c_data), C.ulong(len(data)), destroy, c_data)
+	if err := Status(status).toError(); err != nil {
+		// cairo only calls destroy once it has taken the data.
+		C.free(c_data)
+		panic(err)
+	}
+}
+
 // See cairo_surface_supports_mime_type().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-supports-mime-type
 func (surface *Surface) SupportsMimeType(mimeType string) bool {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	c_mimeType := C.CString(mimeType)
 	defer C.free(unsafe.Pointer(c_mimeType))
 	ret := C.cairo_surface_supports_mime_type(surface.Ptr, c_mimeType) != 0
@@ -2736,10 +4057,32 @@ func (surface *Surface) SupportsMimeType(mimeType string) bool {
 	return ret
 }
 
+// MIME types for SetMIMEData, from the CAIRO_MIME_TYPE_* macros.
+const (
+	MIMETypeJPEG           = "image/jpeg"
+	MIMETypePNG            = "image/png"
+	MIMETypeJP2            = "image/jp2"
+	MIMETypeURI            = "text/x-uri"
+	MIMETypeUniqueID       = "application/x-cairo.uuid"
+	MIMETypeJBIG2          = "application/x-cairo.jbig2"
+	MIMETypeJBIG2Global    = "application/x-cairo.jbig2-global"
+	MIMETypeJBIG2GlobalID  = "application/x-cairo.jbig2-global-id"
+	MIMETypeCCITTFax       = "image/g3fax"
+	MIMETypeCCITTFaxParams = "application/x-cairo.ccitt.params"
+	MIMETypeEPS            = "application/postscript"
+	MIMETypeEPSParams      = "application/x-cairo.eps.params"
+)
+
 // See cairo_surface_get_font_options().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-font-options
 func (surface *Surface) GetFontOptions(options *FontOptions) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_get_font_options(surface.Ptr, options.Ptr)
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2750,6 +4093,9 @@ func (surface *Surface) GetFontOptions(options *FontOptions) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-flush
 func (surface *Surface) Flush() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_flush(surface.Ptr)
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2760,6 +4106,9 @@ func (surface *Surface) Flush() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-mark-dirty
 func (surface *Surface) MarkDirty() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_mark_dirty(surface.Ptr)
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2770,6 +4119,9 @@ func (surface *Surface) MarkDirty() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-mark-dirty-rectangle
 func (surface *Surface) MarkDirtyRectangle(x, y, width, height int) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_mark_dirty_rectangle(surface.Ptr, C.int(x), C.int(y), C.int(width), C.int(height))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2780,6 +4132,9 @@ func (surface *Surface) MarkDirtyRectangle(x, y, width, height int) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-set-device-scale
 func (surface *Surface) SetDeviceScale(xScale, yScale float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_set_device_scale(surface.Ptr, C.double(xScale), C.double(yScale))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2790,6 +4145,9 @@ func (surface *Surface) SetDeviceScale(xScale, yScale float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-device-scale
 func (surface *Surface) GetDeviceScale() (float64, float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var xScale C.double
 	var yScale C.double
 
@@ -2804,6 +4162,9 @@ func (surface *Surface) GetDeviceScale() (float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-set-device-offset
 func (surface *Surface) SetDeviceOffset(xOffset, yOffset float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_set_device_offset(surface.Ptr, C.double(xOffset), C.double(yOffset))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2814,6 +4175,9 @@ func (surface *Surface) SetDeviceOffset(xOffset, yOffset float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-device-offset
 func (surface *Surface) GetDeviceOffset() (float64, float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var xOffset C.double
 	var yOffset C.double
 
@@ -2828,6 +4192,9 @@ func (surface *Surface) GetDeviceOffset() (float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-set-fallback-resolution
 func (surface *Surface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_set_fallback_resolution(surface.Ptr, C.double(xPixelsPerInch), C.double(yPixelsPerInch))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2838,6 +4205,9 @@ func (surface *Surface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch flo
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-get-fallback-resolution
 func (surface *Surface) GetFallbackResolution() (float64, float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var xPixelsPerInch C.double
 	var yPixelsPerInch C.double
 
@@ -2852,6 +4222,9 @@ func (surface *Surface) GetFallbackResolution() (float64, float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-copy-page
 func (surface *Surface) CopyPage() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_copy_page(surface.Ptr)
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2862,6 +4235,9 @@ func (surface *Surface) CopyPage() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-show-page
 func (surface *Surface) ShowPage() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_surface_show_page(surface.Ptr)
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2872,6 +4248,9 @@ func (surface *Surface) ShowPage() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-surface-t.html#cairo-surface-has-show-text-glyphs
 func (surface *Surface) HasShowTextGlyphs() bool {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_surface_has_show_text_glyphs(surface.Ptr) != 0
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2898,18 +4277,66 @@ func (format Format) StrideForWidth(width int) int {
 	return ret
 }
 
+// See cairo_image_surface_create_for_data().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Image-Surfaces.html#cairo-image-surface-create-for-data
+//
+// data must be C memory, or Go memory pinned with runtime.Pinner, and
+// stay valid for as long as the surface lives.
+func ImageSurfaceCreateForData(data unsafe.Pointer, format Format, width, height, stride int) *ImageSurface {
+	ret := &ImageSurface{wrapSurface(C.cairo_image_surface_create_for_data((*C.uchar)(data), C.cairo_format_t(format), C.int(width), C.int(height), C.int(stride)))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
 // See cairo_image_surface_get_data().
 //
 // C API documentation: http://cairographics.org/manual/cairo-Image-Surfaces.html#cairo-image-surface-get-data
 func (i *ImageSurface) Data() []byte {
+	if i.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	buf := C.cairo_image_surface_get_data(i.Ptr)
 	return C.GoBytes(unsafe.Pointer(buf), C.int(i.GetStride()*i.GetHeight()))
 }
 
+// DataView returns the pixels of the surface without copying them, after
+// flushing any pending drawing.  The slice aliases the surface's buffer,
+// so it is only valid until the surface is finished or closed, and
+// writes to it must be followed by MarkDirty.  It is nil if the surface
+// has no data, e.g. because it was finished.
+func (i *ImageSurface) DataView() []byte {
+	if i.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	i.Flush()
+	buf := C.cairo_image_surface_get_data(i.Ptr)
+	if buf == nil {
+		return nil
+	}
+	n := i.GetStride() * i.GetHeight()
+	return unsafe.Slice((*byte)(buf), n)
+}
+
+// UpdateData calls f with DataView to modify the pixels in place, then
+// marks the surface dirty so that cairo picks up the changes.
+func (i *ImageSurface) UpdateData(f func(data []byte)) {
+	if i.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	f(i.DataView())
+	i.MarkDirty()
+}
+
 // See cairo_image_surface_get_format().
 //
 // C API documentation: http://cairographics.org/manual/cairo-Image-Surfaces.html#cairo-image-surface-get-format
 func (surface *ImageSurface) GetFormat() Format {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Format(C.cairo_image_surface_get_format(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2921,6 +4348,9 @@ func (surface *ImageSurface) GetFormat() Format {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Image-Surfaces.html#cairo-image-surface-get-width
 func (surface *ImageSurface) GetWidth() int {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_image_surface_get_width(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2932,6 +4362,9 @@ func (surface *ImageSurface) GetWidth() int {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Image-Surfaces.html#cairo-image-surface-get-height
 func (surface *ImageSurface) GetHeight() int {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_image_surface_get_height(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2943,6 +4376,9 @@ func (surface *ImageSurface) GetHeight() int {
 //
 // C API documentation: http://cairographics.org/manual/cairo-Image-Surfaces.html#cairo-image-surface-get-stride
 func (surface *ImageSurface) GetStride() int {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_image_surface_get_stride(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2965,6 +4401,9 @@ func RecordingSurfaceCreate(content Content, extents *Rectangle) *RecordingSurfa
 //
 // C API documentation: http://cairographics.org/manual/cairo-Recording-Surfaces.html#cairo-recording-surface-ink-extents
 func (surface *RecordingSurface) InkExtents() (float64, float64, float64, float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	var x0 C.double
 	var y0 C.double
 	var width C.double
@@ -2981,6 +4420,9 @@ func (surface *RecordingSurface) InkExtents() (float64, float64, float64, float6
 //
 // C API documentation: http://cairographics.org/manual/cairo-Recording-Surfaces.html#cairo-recording-surface-get-extents
 func (surface *RecordingSurface) GetExtents(extents *Rectangle) bool {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := C.cairo_recording_surface_get_extents(surface.Ptr, (*C.cairo_rectangle_t)(unsafe.Pointer(extents))) != 0
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -2988,11 +4430,45 @@ func (surface *RecordingSurface) GetExtents(extents *Rectangle) bool {
 	return ret
 }
 
+// ReplayTo draws the recording onto target, transformed by m if it isn't
+// nil.
+func (surface *RecordingSurface) ReplayTo(target *Surface, m *Matrix) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	cr := Create(target)
+	defer cr.Close()
+	if m != nil {
+		cr.Transform(m)
+	}
+	cr.SetSourceSurface(surface.Surface, 0, 0)
+	cr.Paint()
+}
+
+// See cairo_pattern_create_raster_source().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Raster-Sources.html#cairo-pattern-create-raster-source
+func RasterSourcePatternCreate(source RasterSource, content Content, width, height int) *RasterSourcePattern {
+	key := newKey(&rasterSourceClosure{source: source, width: width, height: height, refs: 1})
+	p := C.cairo_pattern_create_raster_source(unsafe.Pointer(key), C.cairo_content_t(content), C.int(width), C.int(height))
+	C.cairo_raster_source_pattern_set_acquire(p, (C.cairo_raster_source_acquire_func_t)(unsafe.Pointer(C.gocairo_raster_source_acquire)), (C.cairo_raster_source_release_func_t)(unsafe.Pointer(C.gocairo_raster_source_release)))
+	C.cairo_raster_source_pattern_set_snapshot(p, (C.cairo_raster_source_snapshot_func_t)(unsafe.Pointer(C.gocairo_raster_source_snapshot)))
+	C.cairo_raster_source_pattern_set_copy(p, (C.cairo_raster_source_copy_func_t)(unsafe.Pointer(C.gocairo_raster_source_copy)))
+	C.cairo_raster_source_pattern_set_finish(p, (C.cairo_raster_source_finish_func_t)(unsafe.Pointer(C.gocairo_raster_source_finish)))
+	ret := &RasterSourcePattern{wrapPattern(p)}
+	if err := ret.status(); err != nil {
+		// Error patterns never call finish, so free the key now.
+		C.gocairo_free_key(unsafe.Pointer(key))
+		panic(err)
+	}
+	return ret
+}
+
 // See cairo_pattern_create_rgb().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-create-rgb
-func PatternCreateRGB(red, green, blue float64) *Pattern {
-	ret := wrapPattern(C.cairo_pattern_create_rgb(C.double(red), C.double(green), C.double(blue)))
+func PatternCreateRGB(red, green, blue float64) *SolidPattern {
+	ret := &SolidPattern{wrapPattern(C.cairo_pattern_create_rgb(C.double(red), C.double(green), C.double(blue)))}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -3002,8 +4478,8 @@ func PatternCreateRGB(red, green, blue float64) *Pattern {
 // See cairo_pattern_create_rgba().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-create-rgba
-func PatternCreateRGBA(red, green, blue, alpha float64) *Pattern {
-	ret := wrapPattern(C.cairo_pattern_create_rgba(C.double(red), C.double(green), C.double(blue), C.double(alpha)))
+func PatternCreateRGBA(red, green, blue, alpha float64) *SolidPattern {
+	ret := &SolidPattern{wrapPattern(C.cairo_pattern_create_rgba(C.double(red), C.double(green), C.double(blue), C.double(alpha)))}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -3013,8 +4489,11 @@ func PatternCreateRGBA(red, green, blue, alpha float64) *Pattern {
 // See cairo_pattern_create_for_surface().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-create-for-surface
-func PatternCreateForSurface(surface *Surface) *Pattern {
-	ret := wrapPattern(C.cairo_pattern_create_for_surface(surface.Ptr))
+func PatternCreateForSurface(surface *Surface) *SurfacePattern {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := &SurfacePattern{wrapPattern(C.cairo_pattern_create_for_surface(surface.Ptr))}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -3024,8 +4503,8 @@ func PatternCreateForSurface(surface *Surface) *Pattern {
 // See cairo_pattern_create_linear().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-create-linear
-func PatternCreateLinear(x0, y0, x1, y1 float64) *Pattern {
-	ret := wrapPattern(C.cairo_pattern_create_linear(C.double(x0), C.double(y0), C.double(x1), C.double(y1)))
+func PatternCreateLinear(x0, y0, x1, y1 float64) *LinearGradient {
+	ret := &LinearGradient{wrapPattern(C.cairo_pattern_create_linear(C.double(x0), C.double(y0), C.double(x1), C.double(y1)))}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -3035,8 +4514,8 @@ func PatternCreateLinear(x0, y0, x1, y1 float64) *Pattern {
 // See cairo_pattern_create_radial().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-create-radial
-func PatternCreateRadial(cx0, cy0, radius0, cx1, cy1, radius1 float64) *Pattern {
-	ret := wrapPattern(C.cairo_pattern_create_radial(C.double(cx0), C.double(cy0), C.double(radius0), C.double(cx1), C.double(cy1), C.double(radius1)))
+func PatternCreateRadial(cx0, cy0, radius0, cx1, cy1, radius1 float64) *RadialGradient {
+	ret := &RadialGradient{wrapPattern(C.cairo_pattern_create_radial(C.double(cx0), C.double(cy0), C.double(radius0), C.double(cx1), C.double(cy1), C.double(radius1)))}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -3046,8 +4525,8 @@ func PatternCreateRadial(cx0, cy0, radius0, cx1, cy1, radius1 float64) *Pattern
 // See cairo_pattern_create_mesh().
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-create-mesh
-func PatternCreateMesh() *Pattern {
-	ret := wrapPattern(C.cairo_pattern_create_mesh())
+func PatternCreateMesh() *MeshPattern {
+	ret := &MeshPattern{wrapPattern(C.cairo_pattern_create_mesh())}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
@@ -3100,6 +4579,9 @@ func (i PatternType) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-type
 func (pattern *Pattern) GetType() PatternType {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := PatternType(C.cairo_pattern_get_type(pattern.Ptr))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3111,6 +4593,9 @@ func (pattern *Pattern) GetType() PatternType {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-add-color-stop-rgb
 func (pattern *Pattern) AddColorStopRGB(offset, red, green, blue float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pattern_add_color_stop_rgb(pattern.Ptr, C.double(offset), C.double(red), C.double(green), C.double(blue))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3121,6 +4606,9 @@ func (pattern *Pattern) AddColorStopRGB(offset, red, green, blue float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-add-color-stop-rgba
 func (pattern *Pattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pattern_add_color_stop_rgba(pattern.Ptr, C.double(offset), C.double(red), C.double(green), C.double(blue), C.double(alpha))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3131,6 +4619,9 @@ func (pattern *Pattern) AddColorStopRGBA(offset, red, green, blue, alpha float64
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-begin-patch
 func (pattern *MeshPattern) BeginPatch() {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_begin_patch(pattern.Ptr)
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3141,6 +4632,9 @@ func (pattern *MeshPattern) BeginPatch() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-end-patch
 func (pattern *MeshPattern) EndPatch() {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_end_patch(pattern.Ptr)
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3151,6 +4645,9 @@ func (pattern *MeshPattern) EndPatch() {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-curve-to
 func (pattern *MeshPattern) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_curve_to(pattern.Ptr, C.double(x1), C.double(y1), C.double(x2), C.double(y2), C.double(x3), C.double(y3))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3161,6 +4658,9 @@ func (pattern *MeshPattern) CurveTo(x1, y1, x2, y2, x3, y3 float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-line-to
 func (pattern *MeshPattern) LineTo(x, y float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_line_to(pattern.Ptr, C.double(x), C.double(y))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3171,6 +4671,9 @@ func (pattern *MeshPattern) LineTo(x, y float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-move-to
 func (pattern *MeshPattern) MoveTo(x, y float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_move_to(pattern.Ptr, C.double(x), C.double(y))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3181,6 +4684,9 @@ func (pattern *MeshPattern) MoveTo(x, y float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-set-control-point
 func (pattern *MeshPattern) SetControlPoint(pointNum int, x, y float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_set_control_point(pattern.Ptr, C.uint(pointNum), C.double(x), C.double(y))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3191,6 +4697,9 @@ func (pattern *MeshPattern) SetControlPoint(pointNum int, x, y float64) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-set-corner-color-rgb
 func (pattern *MeshPattern) SetCornerColorRGB(cornerNum int, red, green, blue float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_set_corner_color_rgb(pattern.Ptr, C.uint(cornerNum), C.double(red), C.double(green), C.double(blue))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3201,6 +4710,9 @@ func (pattern *MeshPattern) SetCornerColorRGB(cornerNum int, red, green, blue fl
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-set-corner-color-rgba
 func (pattern *MeshPattern) SetCornerColorRGBA(cornerNum int, red, green, blue, alpha float64) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_mesh_pattern_set_corner_color_rgba(pattern.Ptr, C.uint(cornerNum), C.double(red), C.double(green), C.double(blue), C.double(alpha))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3211,6 +4723,9 @@ func (pattern *MeshPattern) SetCornerColorRGBA(cornerNum int, red, green, blue,
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-set-matrix
 func (pattern *Pattern) SetMatrix(matrix *Matrix) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pattern_set_matrix(pattern.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3221,6 +4736,9 @@ func (pattern *Pattern) SetMatrix(matrix *Matrix) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-matrix
 func (pattern *Pattern) GetMatrix(matrix *Matrix) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pattern_get_matrix(pattern.Ptr, (*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3259,6 +4777,9 @@ func (i Extend) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-set-extend
 func (pattern *Pattern) SetExtend(extend Extend) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pattern_set_extend(pattern.Ptr, C.cairo_extend_t(extend))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3269,6 +4790,9 @@ func (pattern *Pattern) SetExtend(extend Extend) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-extend
 func (pattern *Pattern) GetExtend() Extend {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Extend(C.cairo_pattern_get_extend(pattern.Ptr))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3314,6 +4838,9 @@ func (i Filter) String() string {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-set-filter
 func (pattern *Pattern) SetFilter(filter Filter) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_pattern_set_filter(pattern.Ptr, C.cairo_filter_t(filter))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3324,6 +4851,9 @@ func (pattern *Pattern) SetFilter(filter Filter) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-filter
 func (pattern *Pattern) GetFilter() Filter {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := Filter(C.cairo_pattern_get_filter(pattern.Ptr))
 	if err := pattern.status(); err != nil {
 		panic(err)
@@ -3331,36 +4861,244 @@ func (pattern *Pattern) GetFilter() Filter {
 	return ret
 }
 
-// See cairo_mesh_pattern_get_path().
+// See cairo_pattern_get_rgba().
 //
-// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-get-path
-func (pattern *MeshPattern) GetPath(patchNum int) *Path {
-	ret := wrapPath(C.cairo_mesh_pattern_get_path(pattern.Ptr, C.uint(patchNum)))
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-rgba
+func (pattern *SolidPattern) GetRGBA() (float64, float64, float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var red C.double
+	var green C.double
+	var blue C.double
+	var alpha C.double
+
+	ret := Status(C.cairo_pattern_get_rgba(pattern.Ptr, &red, &green, &blue, &alpha)).toError()
 	if err := pattern.status(); err != nil {
 		panic(err)
 	}
-	return ret
+	return float64(red), float64(green), float64(blue), float64(alpha), ret
 }
 
-// See cairo_matrix_init_identity().
+// See cairo_pattern_get_surface().
 //
-// C API documentation: http://cairographics.org/manual/cairo-cairo-matrix-t.html#cairo-matrix-init-identity
-func (matrix *Matrix) InitIdentity() {
-	C.cairo_matrix_init_identity((*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-surface
+//
+// Use Pattern.ToSurfacePattern to get at the surface of a pattern from
+// e.g. Context.GetSource.
+func (pattern *SurfacePattern) Surface() (*Surface, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var surface *C.cairo_surface_t
+	ret := Status(C.cairo_pattern_get_surface(pattern.Ptr, &surface)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	if ret != nil {
+		return nil, ret
+	}
+	// The pattern still owns surface, so take our own reference.
+	return wrapSurface(C.cairo_surface_reference(surface)), nil
 }
 
-// See cairo_matrix_init_translate().
+// See cairo_pattern_get_color_stop_rgba().
 //
-// C API documentation: http://cairographics.org/manual/cairo-cairo-matrix-t.html#cairo-matrix-init-translate
-func (matrix *Matrix) InitTranslate(tx, ty float64) {
-	C.cairo_matrix_init_translate((*C.cairo_matrix_t)(unsafe.Pointer(matrix)), C.double(tx), C.double(ty))
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-color-stop-rgba
+func (pattern *LinearGradient) GetColorStopRGBA(index int) (float64, float64, float64, float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var offset C.double
+	var red C.double
+	var green C.double
+	var blue C.double
+	var alpha C.double
+
+	ret := Status(C.cairo_pattern_get_color_stop_rgba(pattern.Ptr, C.int(index), &offset, &red, &green, &blue, &alpha)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return float64(offset), float64(red), float64(green), float64(blue), float64(alpha), ret
 }
 
-// See cairo_matrix_init_scale().
+// See cairo_pattern_get_color_stop_rgba().
 //
-// C API documentation: http://cairographics.org/manual/cairo-cairo-matrix-t.html#cairo-matrix-init-scale
-func (matrix *Matrix) InitScale(sx, sy float64) {
-	C.cairo_matrix_init_scale((*C.cairo_matrix_t)(unsafe.Pointer(matrix)), C.double(sx), C.double(sy))
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-color-stop-rgba
+func (pattern *RadialGradient) GetColorStopRGBA(index int) (float64, float64, float64, float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var offset C.double
+	var red C.double
+	var green C.double
+	var blue C.double
+	var alpha C.double
+
+	ret := Status(C.cairo_pattern_get_color_stop_rgba(pattern.Ptr, C.int(index), &offset, &red, &green, &blue, &alpha)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return float64(offset), float64(red), float64(green), float64(blue), float64(alpha), ret
+}
+
+// See cairo_pattern_get_color_stop_count().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-color-stop-count
+func (pattern *LinearGradient) GetColorStopCount() (int, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var count C.int
+
+	ret := Status(C.cairo_pattern_get_color_stop_count(pattern.Ptr, &count)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return int(count), ret
+}
+
+// See cairo_pattern_get_color_stop_count().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-color-stop-count
+func (pattern *RadialGradient) GetColorStopCount() (int, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var count C.int
+
+	ret := Status(C.cairo_pattern_get_color_stop_count(pattern.Ptr, &count)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return int(count), ret
+}
+
+// See cairo_pattern_get_linear_points().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-linear-points
+func (pattern *LinearGradient) GetLinearPoints() (float64, float64, float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var x0 C.double
+	var y0 C.double
+	var x1 C.double
+	var y1 C.double
+
+	ret := Status(C.cairo_pattern_get_linear_points(pattern.Ptr, &x0, &y0, &x1, &y1)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return float64(x0), float64(y0), float64(x1), float64(y1), ret
+}
+
+// See cairo_pattern_get_radial_circles().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-pattern-get-radial-circles
+func (pattern *RadialGradient) GetRadialCircles() (float64, float64, float64, float64, float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var x0 C.double
+	var y0 C.double
+	var r0 C.double
+	var x1 C.double
+	var y1 C.double
+	var r1 C.double
+
+	ret := Status(C.cairo_pattern_get_radial_circles(pattern.Ptr, &x0, &y0, &r0, &x1, &y1, &r1)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return float64(x0), float64(y0), float64(r0), float64(x1), float64(y1), float64(r1), ret
+}
+
+// See cairo_mesh_pattern_get_patch_count().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-get-patch-count
+func (pattern *MeshPattern) GetPatchCount() (int, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var count C.uint
+
+	ret := Status(C.cairo_mesh_pattern_get_patch_count(pattern.Ptr, &count)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return int(count), ret
+}
+
+// See cairo_mesh_pattern_get_path().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-get-path
+func (pattern *MeshPattern) GetPath(patchNum int) *Path {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapPath(C.cairo_mesh_pattern_get_path(pattern.Ptr, C.uint(patchNum)))
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_mesh_pattern_get_corner_color_rgba().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-get-corner-color-rgba
+func (pattern *MeshPattern) GetCornerColorRGBA(patchNum, cornerNum int) (float64, float64, float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var red C.double
+	var green C.double
+	var blue C.double
+	var alpha C.double
+
+	ret := Status(C.cairo_mesh_pattern_get_corner_color_rgba(pattern.Ptr, C.uint(patchNum), C.uint(cornerNum), &red, &green, &blue, &alpha)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return float64(red), float64(green), float64(blue), float64(alpha), ret
+}
+
+// See cairo_mesh_pattern_get_control_point().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-pattern-t.html#cairo-mesh-pattern-get-control-point
+func (pattern *MeshPattern) GetControlPoint(patchNum, pointNum int) (float64, float64, error) {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var x C.double
+	var y C.double
+
+	ret := Status(C.cairo_mesh_pattern_get_control_point(pattern.Ptr, C.uint(patchNum), C.uint(pointNum), &x, &y)).toError()
+	if err := pattern.status(); err != nil {
+		panic(err)
+	}
+	return float64(x), float64(y), ret
+}
+
+// See cairo_matrix_init_identity().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-matrix-t.html#cairo-matrix-init-identity
+func (matrix *Matrix) InitIdentity() {
+	C.cairo_matrix_init_identity((*C.cairo_matrix_t)(unsafe.Pointer(matrix)))
+}
+
+// See cairo_matrix_init_translate().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-matrix-t.html#cairo-matrix-init-translate
+func (matrix *Matrix) InitTranslate(tx, ty float64) {
+	C.cairo_matrix_init_translate((*C.cairo_matrix_t)(unsafe.Pointer(matrix)), C.double(tx), C.double(ty))
+}
+
+// See cairo_matrix_init_scale().
+//
+// C API documentation: http://cairographics.org/manual/cairo-cairo-matrix-t.html#cairo-matrix-init-scale
+func (matrix *Matrix) InitScale(sx, sy float64) {
+	C.cairo_matrix_init_scale((*C.cairo_matrix_t)(unsafe.Pointer(matrix)), C.double(sx), C.double(sy))
 }
 
 // See cairo_matrix_init_rotate().
@@ -3430,6 +5168,16 @@ type Region struct {
 func freeRegion(obj *Region) {
 	C.cairo_region_destroy(obj.Ptr)
 }
+
+// Close releases the C cairo_region_t* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from BorrowRegion.
+func (obj *Region) Close() error {
+	if obj.Ptr != nil {
+		C.cairo_region_destroy(obj.Ptr)
+		obj.Ptr = nil
+		runtime.SetFinalizer(obj, nil)
+	}
+	return nil
+}
 func wrapRegion(p *C.cairo_region_t) *Region {
 	ret := &Region{p}
 	runtime.SetFinalizer(ret, freeRegion)
@@ -3446,157 +5194,813 @@ func BorrowRegion(p unsafe.Pointer) *Region {
 	return &Region{(*C.cairo_region_t)(p)}
 }
 
-// See cairo_region_overlap_t.
+// See cairo_region_overlap_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-overlap-t
+type RegionOverlap int
+
+const (
+	RegionOverlapIn   RegionOverlap = C.CAIRO_REGION_OVERLAP_IN
+	RegionOverlapOut  RegionOverlap = C.CAIRO_REGION_OVERLAP_OUT
+	RegionOverlapPart RegionOverlap = C.CAIRO_REGION_OVERLAP_PART
+)
+
+// String implements the Stringer interface, which is used in places like fmt's %q.  For all enums like this it returns the Go name of the constant.
+func (i RegionOverlap) String() string {
+	switch i {
+	case RegionOverlapIn:
+		return "RegionOverlapIn"
+	case RegionOverlapOut:
+		return "RegionOverlapOut"
+	case RegionOverlapPart:
+		return "RegionOverlapPart"
+	default:
+		return fmt.Sprintf("RegionOverlap(%d)", i)
+	}
+}
+
+// See cairo_region_create().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-create
+func RegionCreate() *Region {
+	ret := wrapRegion(C.cairo_region_create())
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_create_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-create-rectangle
+func RegionCreateRectangle(rectangle *RectangleInt) *Region {
+	ret := wrapRegion(C.cairo_region_create_rectangle((*C.cairo_rectangle_int_t)(unsafe.Pointer(rectangle))))
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_create_rectangles().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-create-rectangles
+func RegionCreateRectangles(rects []RectangleInt) *Region {
+	ret := wrapRegion(C.cairo_region_create_rectangles((*C.cairo_rectangle_int_t)(sliceBytes(unsafe.Pointer(&rects))), C.int(len(rects))))
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_copy().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-copy
+func (original *Region) Copy() *Region {
+	if original.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapRegion(C.cairo_region_copy(original.Ptr))
+	if err := original.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_equal().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-equal
+func (a *Region) Equal(b *Region) bool {
+	if a.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if b.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := C.cairo_region_equal(a.Ptr, b.Ptr) != 0
+	if err := a.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_status().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-status
+func (region *Region) status() error {
+	ret := Status(C.cairo_region_status(region.Ptr)).toError()
+	return ret
+}
+
+// See cairo_region_get_extents().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-get-extents
+func (region *Region) GetExtents() RectangleInt {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	var extents RectangleInt
+
+	C.cairo_region_get_extents(region.Ptr, (*C.cairo_rectangle_int_t)(unsafe.Pointer(&extents)))
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+	return extents
+}
+
+// See cairo_region_num_rectangles().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-num-rectangles
+func (region *Region) NumRectangles() int {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := int(C.cairo_region_num_rectangles(region.Ptr))
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_get_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-get-rectangle
+func (region *Region) GetRectangle(nth int) RectangleInt {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	// cairo doesn't check nth itself.
+	if nth < 0 || nth >= region.NumRectangles() {
+		panic(StatusInvalidIndex)
+	}
+	var rectangle RectangleInt
+	C.cairo_region_get_rectangle(region.Ptr, C.int(nth), (*C.cairo_rectangle_int_t)(unsafe.Pointer(&rectangle)))
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+	return rectangle
+}
+
+// See cairo_region_is_empty().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-is-empty
+func (region *Region) IsEmpty() bool {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := C.cairo_region_is_empty(region.Ptr) != 0
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_contains_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-contains-rectangle
+func (region *Region) ContainsRectangle(rectangle *RectangleInt) RegionOverlap {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := RegionOverlap(C.cairo_region_contains_rectangle(region.Ptr, (*C.cairo_rectangle_int_t)(unsafe.Pointer(rectangle))))
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_contains_point().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-contains-point
+func (region *Region) ContainsPoint(x, y int) bool {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := C.cairo_region_contains_point(region.Ptr, C.int(x), C.int(y)) != 0
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_translate().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-translate
+func (region *Region) Translate(dx, dy int) {
+	if region.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_region_translate(region.Ptr, C.int(dx), C.int(dy))
+	if err := region.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_region_subtract().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-subtract
+func (dst *Region) Subtract(other *Region) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_subtract(dst.Ptr, other.Ptr)).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_subtract_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-subtract-rectangle
+func (dst *Region) SubtractRectangle(rectangle *RectangleInt) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_subtract_rectangle(dst.Ptr, (*C.cairo_rectangle_int_t)(unsafe.Pointer(rectangle)))).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_intersect().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-intersect
+func (dst *Region) Intersect(other *Region) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_intersect(dst.Ptr, other.Ptr)).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_intersect_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-intersect-rectangle
+func (dst *Region) IntersectRectangle(rectangle *RectangleInt) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_intersect_rectangle(dst.Ptr, (*C.cairo_rectangle_int_t)(unsafe.Pointer(rectangle)))).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_union().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-union
+func (dst *Region) Union(other *Region) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_union(dst.Ptr, other.Ptr)).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_union_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-union-rectangle
+func (dst *Region) UnionRectangle(rectangle *RectangleInt) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_union_rectangle(dst.Ptr, (*C.cairo_rectangle_int_t)(unsafe.Pointer(rectangle)))).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_xor().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-xor
+func (dst *Region) XOR(other *Region) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if other.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_xor(dst.Ptr, other.Ptr)).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_region_xor_rectangle().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-xor-rectangle
+func (dst *Region) XORRectangle(rectangle *RectangleInt) error {
+	if dst.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_region_xor_rectangle(dst.Ptr, (*C.cairo_rectangle_int_t)(unsafe.Pointer(rectangle)))).toError()
+	if err := dst.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_ft_font_face_create_for_ft_face().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-font-face-create-for-ft-face
+func FTFontFaceCreateForFTFace(face unsafe.Pointer, loadFlags int) *FTFontFace {
+	ret := &FTFontFace{wrapFontFace(C.cairo_ft_font_face_create_for_ft_face(C.FT_Face(face), C.int(loadFlags)))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// FTFontFaceCreateForFile loads the face at index within the font file
+// at path using FreeType.  loadFlags are FreeType's FT_LOAD_* flags, as
+// for FTFontFaceCreateForFTFace.
+func FTFontFaceCreateForFile(path string, index, loadFlags int) (*FTFontFace, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+	var ftErr C.FT_Error
+	p := C.gocairo_ft_font_face_create(cPath, nil, 0, C.long(index), C.int(loadFlags), &ftErr)
+	if p == nil {
+		return nil, fmt.Errorf("cairo: loading %q: FreeType error %d", path, ftErr)
+	}
+	ret := &FTFontFace{wrapFontFace(p)}
+	if err := ret.status(); err != nil {
+		ret.Close()
+		return nil, err
+	}
+	return ret, nil
+}
+
+// FTFontFaceCreateForData is like FTFontFaceCreateForFile, but loads the
+// font from memory, such as a file from an embed.FS.  data is copied.
+func FTFontFaceCreateForData(data []byte, index, loadFlags int) (*FTFontFace, error) {
+	var ftErr C.FT_Error
+	p := C.gocairo_ft_font_face_create(nil, C.CBytes(data), C.long(len(data)), C.long(index), C.int(loadFlags), &ftErr)
+	if p == nil {
+		return nil, fmt.Errorf("cairo: loading font: FreeType error %d", ftErr)
+	}
+	ret := &FTFontFace{wrapFontFace(p)}
+	if err := ret.status(); err != nil {
+		ret.Close()
+		return nil, err
+	}
+	return ret, nil
+}
+
+// See cairo_ft_synthesize_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-synthesize-t
+type FTSynthesize int
+
+const (
+	FTSynthesizeBold    FTSynthesize = C.CAIRO_FT_SYNTHESIZE_BOLD
+	FTSynthesizeOblique FTSynthesize = C.CAIRO_FT_SYNTHESIZE_OBLIQUE
+)
+
+// String implements the Stringer interface, which is used in places like fmt's %q.  For all enums like this it returns the Go name of the constant.
+func (i FTSynthesize) String() string {
+	switch i {
+	case FTSynthesizeBold:
+		return "FTSynthesizeBold"
+	case FTSynthesizeOblique:
+		return "FTSynthesizeOblique"
+	default:
+		return fmt.Sprintf("FTSynthesize(%d)", i)
+	}
+}
+
+// See cairo_ft_font_face_set_synthesize().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-font-face-set-synthesize
+func (fontFace *FTFontFace) SetSynthesize(synthFlags FTSynthesize) {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ft_font_face_set_synthesize(fontFace.Ptr, C.uint(synthFlags))
+	if err := fontFace.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_ft_font_face_unset_synthesize().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-font-face-unset-synthesize
+func (fontFace *FTFontFace) UnsetSynthesize(synthFlags FTSynthesize) {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ft_font_face_unset_synthesize(fontFace.Ptr, C.uint(synthFlags))
+	if err := fontFace.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_ft_font_face_get_synthesize().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-font-face-get-synthesize
+func (fontFace *FTFontFace) GetSynthesize() FTSynthesize {
+	if fontFace.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := FTSynthesize(C.cairo_ft_font_face_get_synthesize(fontFace.Ptr))
+	if err := fontFace.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_ft_scaled_font_lock_face().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-scaled-font-lock-face
+func (scaledFont *FTScaledFont) LockFace() unsafe.Pointer {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := unsafe.Pointer(C.cairo_ft_scaled_font_lock_face(scaledFont.Ptr))
+	if err := scaledFont.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_ft_scaled_font_unlock_face().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-scaled-font-unlock-face
+func (scaledFont *FTScaledFont) UnlockFace() {
+	if scaledFont.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ft_scaled_font_unlock_face(scaledFont.Ptr)
+	if err := scaledFont.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_ft_font_face_create_for_pattern().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-font-face-create-for-pattern
+//
+// Build the pattern from a fontconfig name with FcNameParse.  The face
+// keeps its own copy, so pattern can be closed afterwards.
+func FTFontFaceCreateForPattern(pattern *FcPattern) *FTFontFace {
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := &FTFontFace{wrapFontFace(C.cairo_ft_font_face_create_for_pattern(pattern.Ptr))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// FcPattern is a fontconfig pattern describing a font, for
+// FTFontFaceCreateForPattern and FTFontOptionsSubstitute.
+type FcPattern struct {
+	Ptr *C.FcPattern
+}
+
+// FcNameParse parses a fontconfig font name, such as "DejaVu Sans:bold"
+// or "Monospace-12", into a pattern.
+func FcNameParse(name string) (*FcPattern, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	p := C.FcNameParse((*C.FcChar8)(unsafe.Pointer(cName)))
+	if p == nil {
+		return nil, fmt.Errorf("cairo: can't parse font name %q", name)
+	}
+	pattern := &FcPattern{p}
+	runtime.SetFinalizer(pattern, (*FcPattern).Close)
+	return pattern, nil
+}
+
+// String returns the pattern as a fontconfig font name, e.g. to see what
+// FTFontOptionsSubstitute added to it.
+func (pattern *FcPattern) String() string {
+	if pattern.Ptr == nil {
+		return ""
+	}
+	cName := C.FcNameUnparse(pattern.Ptr)
+	defer C.free(unsafe.Pointer(cName))
+	return C.GoString((*C.char)(unsafe.Pointer(cName)))
+}
+
+// Close releases the C FcPattern* without waiting for the garbage
+// collector.  It is safe to call Close more than once.  The error result
+// is always nil and is only there to implement io.Closer.
+func (pattern *FcPattern) Close() error {
+	if pattern.Ptr != nil {
+		C.FcPatternDestroy(pattern.Ptr)
+		pattern.Ptr = nil
+	}
+	runtime.SetFinalizer(pattern, nil)
+	return nil
+}
+
+// See cairo_ft_font_options_substitute().
+//
+// C API documentation: http://cairographics.org/manual/cairo-FreeType-Fonts.html#cairo-ft-font-options-substitute
+func FTFontOptionsSubstitute(options *FontOptions, pattern *FcPattern) {
+	if options.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if pattern.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ft_font_options_substitute(options.Ptr, pattern.Ptr)
+}
+
+// See cairo_pdf_version_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-version-t
+type PDFVersion int
+
+const (
+	PDFVersion14 PDFVersion = C.CAIRO_PDF_VERSION_1_4
+	PDFVersion15 PDFVersion = C.CAIRO_PDF_VERSION_1_5
+)
+
+// String implements the Stringer interface, which is used in places like fmt's %q.  For all enums like this it returns the Go name of the constant.
+func (i PDFVersion) String() string {
+	switch i {
+	case PDFVersion14:
+		return "PDFVersion14"
+	case PDFVersion15:
+		return "PDFVersion15"
+	default:
+		return fmt.Sprintf("PDFVersion(%d)", i)
+	}
+}
+
+// See cairo_pdf_surface_create().
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-surface-create
+func PDFSurfaceCreate(filename string, widthInPoints, heightInPoints float64) *PDFSurface {
+	c_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(c_filename))
+	ret := &PDFSurface{wrapSurface(C.cairo_pdf_surface_create(c_filename, C.double(widthInPoints), C.double(heightInPoints)))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_pdf_surface_create_for_stream().
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-surface-create-for-stream
+func PDFSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) *PDFSurface {
+	key := newStreamKey(w)
+	surf := C.cairo_pdf_surface_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key), C.double(widthInPoints), C.double(heightInPoints))
+	attachStreamKey(surf, key)
+	ret := &PDFSurface{wrapSurface(surf)}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+func init() {
+	vectorExportFormats["pdf"] = func(w io.Writer, widthInPoints, heightInPoints float64) *Surface {
+		return PDFSurfaceCreateForStream(w, widthInPoints, heightInPoints).Surface
+	}
+}
+
+// See cairo_pdf_surface_restrict_to_version().
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-surface-restrict-to-version
+func (surface *PDFSurface) RestrictToVersion(version PDFVersion) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_pdf_surface_restrict_to_version(surface.Ptr, C.cairo_pdf_version_t(version))
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_pdf_get_versions().
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-get-versions
+func PDFGetVersions() []PDFVersion {
+	var cVersionsPtr *C.cairo_pdf_version_t
+	var cNumVersions C.int
+	C.cairo_pdf_get_versions(&cVersionsPtr, &cNumVersions)
+	slice := (*[1 << 30]C.cairo_pdf_version_t)(unsafe.Pointer(cVersionsPtr))[:cNumVersions:cNumVersions]
+	versions := make([]PDFVersion, cNumVersions)
+	for i := 0; i < int(cNumVersions); i++ {
+		versions[i] = PDFVersion(slice[i])
+	}
+	return versions
+}
+
+// See cairo_pdf_version_to_string().
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-version-to-string
+func (version PDFVersion) ToString() string {
+	ret := C.GoString(C.cairo_pdf_version_to_string(C.cairo_pdf_version_t(version)))
+	return ret
+}
+
+// See cairo_pdf_surface_set_size().
+//
+// C API documentation: http://cairographics.org/manual/cairo-PDF-Surfaces.html#cairo-pdf-surface-set-size
+func (surface *PDFSurface) SetSize(widthInPoints, heightInPoints float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_pdf_surface_set_size(surface.Ptr, C.double(widthInPoints), C.double(heightInPoints))
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_ps_level_t.
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-overlap-t
-type RegionOverlap int
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-level-t
+type PSLevel int
 
 const (
-	RegionOverlapIn   RegionOverlap = C.CAIRO_REGION_OVERLAP_IN
-	RegionOverlapOut  RegionOverlap = C.CAIRO_REGION_OVERLAP_OUT
-	RegionOverlapPart RegionOverlap = C.CAIRO_REGION_OVERLAP_PART
+	PSLevel2 PSLevel = C.CAIRO_PS_LEVEL_2
+	PSLevel3 PSLevel = C.CAIRO_PS_LEVEL_3
 )
 
 // String implements the Stringer interface, which is used in places like fmt's %q.  For all enums like this it returns the Go name of the constant.
-func (i RegionOverlap) String() string {
+func (i PSLevel) String() string {
 	switch i {
-	case RegionOverlapIn:
-		return "RegionOverlapIn"
-	case RegionOverlapOut:
-		return "RegionOverlapOut"
-	case RegionOverlapPart:
-		return "RegionOverlapPart"
+	case PSLevel2:
+		return "PSLevel2"
+	case PSLevel3:
+		return "PSLevel3"
 	default:
-		return fmt.Sprintf("RegionOverlap(%d)", i)
+		return fmt.Sprintf("PSLevel(%d)", i)
 	}
 }
 
-// See cairo_region_create().
+// See cairo_ps_surface_create().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-create
-func RegionCreate() *Region {
-	ret := wrapRegion(C.cairo_region_create())
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-create
+func PSSurfaceCreate(filename string, widthInPoints, heightInPoints float64) *PSSurface {
+	c_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(c_filename))
+	ret := &PSSurface{wrapSurface(C.cairo_ps_surface_create(c_filename, C.double(widthInPoints), C.double(heightInPoints)))}
 	if err := ret.status(); err != nil {
 		panic(err)
 	}
 	return ret
 }
 
-// See cairo_region_copy().
+// See cairo_ps_surface_create_for_stream().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-copy
-func (original *Region) Copy() *Region {
-	ret := wrapRegion(C.cairo_region_copy(original.Ptr))
-	if err := original.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-create-for-stream
+func PSSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) *PSSurface {
+	key := newStreamKey(w)
+	surf := C.cairo_ps_surface_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key), C.double(widthInPoints), C.double(heightInPoints))
+	attachStreamKey(surf, key)
+	ret := &PSSurface{wrapSurface(surf)}
+	if err := ret.status(); err != nil {
 		panic(err)
 	}
 	return ret
 }
 
-// See cairo_region_equal().
-//
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-equal
-func (a *Region) Equal(b *Region) bool {
-	ret := C.cairo_region_equal(a.Ptr, b.Ptr) != 0
-	if err := a.status(); err != nil {
-		panic(err)
+func init() {
+	vectorExportFormats["ps"] = func(w io.Writer, widthInPoints, heightInPoints float64) *Surface {
+		return PSSurfaceCreateForStream(w, widthInPoints, heightInPoints).Surface
 	}
-	return ret
 }
 
-// See cairo_region_status().
+// See cairo_ps_surface_restrict_to_level().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-status
-func (region *Region) status() error {
-	ret := Status(C.cairo_region_status(region.Ptr)).toError()
-	return ret
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-restrict-to-level
+func (surface *PSSurface) RestrictToLevel(level PSLevel) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ps_surface_restrict_to_level(surface.Ptr, C.cairo_ps_level_t(level))
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
 }
 
-// See cairo_region_num_rectangles().
+// See cairo_ps_get_levels().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-num-rectangles
-func (region *Region) NumRectangles() int {
-	ret := int(C.cairo_region_num_rectangles(region.Ptr))
-	if err := region.status(); err != nil {
-		panic(err)
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-get-levels
+func PSGetLevels() []PSLevel {
+	var cLevelsPtr *C.cairo_ps_level_t
+	var cNumLevels C.int
+	C.cairo_ps_get_levels(&cLevelsPtr, &cNumLevels)
+	slice := (*[1 << 30]C.cairo_ps_level_t)(unsafe.Pointer(cLevelsPtr))[:cNumLevels:cNumLevels]
+	levels := make([]PSLevel, cNumLevels)
+	for i := 0; i < int(cNumLevels); i++ {
+		levels[i] = PSLevel(slice[i])
 	}
-	return ret
+	return levels
 }
 
-// See cairo_region_is_empty().
+// See cairo_ps_level_to_string().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-is-empty
-func (region *Region) IsEmpty() bool {
-	ret := C.cairo_region_is_empty(region.Ptr) != 0
-	if err := region.status(); err != nil {
-		panic(err)
-	}
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-level-to-string
+func (level PSLevel) ToString() string {
+	ret := C.GoString(C.cairo_ps_level_to_string(C.cairo_ps_level_t(level)))
 	return ret
 }
 
-// See cairo_region_contains_point().
+// See cairo_ps_surface_set_eps().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-contains-point
-func (region *Region) ContainsPoint(x, y int) bool {
-	ret := C.cairo_region_contains_point(region.Ptr, C.int(x), C.int(y)) != 0
-	if err := region.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-set-eps
+func (surface *PSSurface) SetEPS(eps bool) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ps_surface_set_eps(surface.Ptr, C.cairo_bool_t(cBool(eps)))
+	if err := surface.status(); err != nil {
 		panic(err)
 	}
-	return ret
 }
 
-// See cairo_region_translate().
+// See cairo_ps_surface_get_eps().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-translate
-func (region *Region) Translate(dx, dy int) {
-	C.cairo_region_translate(region.Ptr, C.int(dx), C.int(dy))
-	if err := region.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-get-eps
+func (surface *PSSurface) GetEPS() bool {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := C.cairo_ps_surface_get_eps(surface.Ptr) != 0
+	if err := surface.status(); err != nil {
 		panic(err)
 	}
+	return ret
 }
 
-// See cairo_region_subtract().
+// See cairo_ps_surface_set_size().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-subtract
-func (dst *Region) Subtract(other *Region) error {
-	ret := Status(C.cairo_region_subtract(dst.Ptr, other.Ptr)).toError()
-	if err := dst.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-set-size
+func (surface *PSSurface) SetSize(widthInPoints, heightInPoints float64) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ps_surface_set_size(surface.Ptr, C.double(widthInPoints), C.double(heightInPoints))
+	if err := surface.status(); err != nil {
 		panic(err)
 	}
-	return ret
 }
 
-// See cairo_region_intersect().
+// See cairo_ps_surface_dsc_comment().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-intersect
-func (dst *Region) Intersect(other *Region) error {
-	ret := Status(C.cairo_region_intersect(dst.Ptr, other.Ptr)).toError()
-	if err := dst.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-dsc-comment
+func (surface *PSSurface) DSCComment(comment string) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_comment := C.CString(comment)
+	defer C.free(unsafe.Pointer(c_comment))
+	C.cairo_ps_surface_dsc_comment(surface.Ptr, c_comment)
+	if err := surface.status(); err != nil {
 		panic(err)
 	}
-	return ret
 }
 
-// See cairo_region_union().
+// See cairo_ps_surface_dsc_begin_setup().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-union
-func (dst *Region) Union(other *Region) error {
-	ret := Status(C.cairo_region_union(dst.Ptr, other.Ptr)).toError()
-	if err := dst.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-dsc-begin-setup
+func (surface *PSSurface) DSCBeginSetup() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ps_surface_dsc_begin_setup(surface.Ptr)
+	if err := surface.status(); err != nil {
 		panic(err)
 	}
-	return ret
 }
 
-// See cairo_region_xor().
+// See cairo_ps_surface_dsc_begin_page_setup().
 //
-// C API documentation: http://cairographics.org/manual/cairo-Regions.html#cairo-region-xor
-func (dst *Region) XOR(other *Region) error {
-	ret := Status(C.cairo_region_xor(dst.Ptr, other.Ptr)).toError()
-	if err := dst.status(); err != nil {
+// C API documentation: http://cairographics.org/manual/cairo-PostScript-Surfaces.html#cairo-ps-surface-dsc-begin-page-setup
+func (surface *PSSurface) DSCBeginPageSetup() {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_ps_surface_dsc_begin_page_setup(surface.Ptr)
+	if err := surface.status(); err != nil {
 		panic(err)
 	}
-	return ret
 }
 
 // See cairo_svg_version_t.
@@ -3621,6 +6025,52 @@ func (i SVGVersion) String() string {
 	}
 }
 
+// See cairo_svg_unit_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-SVG-Surfaces.html#cairo-svg-unit-t
+type SVGUnit int
+
+const (
+	SVGUnitUser    SVGUnit = C.CAIRO_SVG_UNIT_USER
+	SVGUnitEm      SVGUnit = C.CAIRO_SVG_UNIT_EM
+	SVGUnitEx      SVGUnit = C.CAIRO_SVG_UNIT_EX
+	SVGUnitPx      SVGUnit = C.CAIRO_SVG_UNIT_PX
+	SVGUnitIn      SVGUnit = C.CAIRO_SVG_UNIT_IN
+	SVGUnitCm      SVGUnit = C.CAIRO_SVG_UNIT_CM
+	SVGUnitMm      SVGUnit = C.CAIRO_SVG_UNIT_MM
+	SVGUnitPt      SVGUnit = C.CAIRO_SVG_UNIT_PT
+	SVGUnitPc      SVGUnit = C.CAIRO_SVG_UNIT_PC
+	SVGUnitPercent SVGUnit = C.CAIRO_SVG_UNIT_PERCENT
+)
+
+// String implements the Stringer interface, which is used in places like fmt's %q.  For all enums like this it returns the Go name of the constant.
+func (i SVGUnit) String() string {
+	switch i {
+	case SVGUnitUser:
+		return "SVGUnitUser"
+	case SVGUnitEm:
+		return "SVGUnitEm"
+	case SVGUnitEx:
+		return "SVGUnitEx"
+	case SVGUnitPx:
+		return "SVGUnitPx"
+	case SVGUnitIn:
+		return "SVGUnitIn"
+	case SVGUnitCm:
+		return "SVGUnitCm"
+	case SVGUnitMm:
+		return "SVGUnitMm"
+	case SVGUnitPt:
+		return "SVGUnitPt"
+	case SVGUnitPc:
+		return "SVGUnitPc"
+	case SVGUnitPercent:
+		return "SVGUnitPercent"
+	default:
+		return fmt.Sprintf("SVGUnit(%d)", i)
+	}
+}
+
 // See cairo_svg_surface_create().
 //
 // C API documentation: http://cairographics.org/manual/cairo-SVG-Surfaces.html#cairo-svg-surface-create
@@ -3634,10 +6084,33 @@ func SVGSurfaceCreate(filename string, widthInPoints, heightInPoints float64) *S
 	return ret
 }
 
+// See cairo_svg_surface_create_for_stream().
+//
+// C API documentation: http://cairographics.org/manual/cairo-SVG-Surfaces.html#cairo-svg-surface-create-for-stream
+func SVGSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) *SVGSurface {
+	key := newStreamKey(w)
+	surf := C.cairo_svg_surface_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key), C.double(widthInPoints), C.double(heightInPoints))
+	attachStreamKey(surf, key)
+	ret := &SVGSurface{wrapSurface(surf)}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+func init() {
+	vectorExportFormats["svg"] = func(w io.Writer, widthInPoints, heightInPoints float64) *Surface {
+		return SVGSurfaceCreateForStream(w, widthInPoints, heightInPoints).Surface
+	}
+}
+
 // See cairo_svg_surface_restrict_to_version().
 //
 // C API documentation: http://cairographics.org/manual/cairo-SVG-Surfaces.html#cairo-svg-surface-restrict-to-version
 func (surface *SVGSurface) RestrictToVersion(version SVGVersion) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_svg_surface_restrict_to_version(surface.Ptr, C.cairo_svg_version_t(version))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3667,6 +6140,33 @@ func (version SVGVersion) ToString() string {
 	return ret
 }
 
+// See cairo_svg_surface_set_document_unit().
+//
+// C API documentation: http://cairographics.org/manual/cairo-SVG-Surfaces.html#cairo-svg-surface-set-document-unit
+func (surface *SVGSurface) SetDocumentUnit(unit SVGUnit) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_svg_surface_set_document_unit(surface.Ptr, C.cairo_svg_unit_t(unit))
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_svg_surface_get_document_unit().
+//
+// C API documentation: http://cairographics.org/manual/cairo-SVG-Surfaces.html#cairo-svg-surface-get-document-unit
+func (surface *SVGSurface) GetDocumentUnit() SVGUnit {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := SVGUnit(C.cairo_svg_surface_get_document_unit(surface.Ptr))
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
 // See cairo_xlib_surface_create().
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-create
@@ -3693,6 +6193,9 @@ func XlibSurfaceCreateForBitmap(dpy unsafe.Pointer, bitmap uint64, screen unsafe
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-set-size
 func (surface *XlibSurface) SetSize(width, height int) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_xlib_surface_set_size(surface.Ptr, C.int(width), C.int(height))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3703,6 +6206,9 @@ func (surface *XlibSurface) SetSize(width, height int) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-set-drawable
 func (surface *XlibSurface) SetDrawable(drawable uint64, width, height int) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_xlib_surface_set_drawable(surface.Ptr, C.Drawable(drawable), C.int(width), C.int(height))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3713,6 +6219,9 @@ func (surface *XlibSurface) SetDrawable(drawable uint64, width, height int) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-display
 func (surface *XlibSurface) GetDisplay() unsafe.Pointer {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := unsafe.Pointer(C.cairo_xlib_surface_get_display(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3724,6 +6233,9 @@ func (surface *XlibSurface) GetDisplay() unsafe.Pointer {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-drawable
 func (surface *XlibSurface) GetDrawable() uint64 {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := uint64(C.cairo_xlib_surface_get_drawable(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3735,6 +6247,9 @@ func (surface *XlibSurface) GetDrawable() uint64 {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-screen
 func (surface *XlibSurface) GetScreen() unsafe.Pointer {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := unsafe.Pointer(C.cairo_xlib_surface_get_screen(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3746,6 +6261,9 @@ func (surface *XlibSurface) GetScreen() unsafe.Pointer {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-visual
 func (surface *XlibSurface) GetVisual() unsafe.Pointer {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := unsafe.Pointer(C.cairo_xlib_surface_get_visual(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3757,6 +6275,9 @@ func (surface *XlibSurface) GetVisual() unsafe.Pointer {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-depth
 func (surface *XlibSurface) GetDepth() int {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_xlib_surface_get_depth(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3768,6 +6289,9 @@ func (surface *XlibSurface) GetDepth() int {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-width
 func (surface *XlibSurface) GetWidth() int {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_xlib_surface_get_width(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3779,6 +6303,9 @@ func (surface *XlibSurface) GetWidth() int {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-surface-get-height
 func (surface *XlibSurface) GetHeight() int {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_xlib_surface_get_height(surface.Ptr))
 	if err := surface.status(); err != nil {
 		panic(err)
@@ -3790,6 +6317,9 @@ func (surface *XlibSurface) GetHeight() int {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-device-debug-cap-xrender-version
 func (device *XlibDevice) DebugCapXrenderVersion(majorVersion, minorVersion int) {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_xlib_device_debug_cap_xrender_version(device.Ptr, C.int(majorVersion), C.int(minorVersion))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -3800,6 +6330,9 @@ func (device *XlibDevice) DebugCapXrenderVersion(majorVersion, minorVersion int)
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-device-debug-set-precision
 func (device *XlibDevice) DebugSetPrecision(precision int) {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	C.cairo_xlib_device_debug_set_precision(device.Ptr, C.int(precision))
 	if err := device.status(); err != nil {
 		panic(err)
@@ -3810,9 +6343,203 @@ func (device *XlibDevice) DebugSetPrecision(precision int) {
 //
 // C API documentation: http://cairographics.org/manual/cairo-XLib-Surfaces.html#cairo-xlib-device-debug-get-precision
 func (device *XlibDevice) DebugGetPrecision() int {
+	if device.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	ret := int(C.cairo_xlib_device_debug_get_precision(device.Ptr))
 	if err := device.status(); err != nil {
 		panic(err)
 	}
 	return ret
 }
+
+// See cairo_script_mode_t.
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-mode-t
+type ScriptMode int
+
+const (
+	ScriptModeASCII  ScriptMode = C.CAIRO_SCRIPT_MODE_ASCII
+	ScriptModeBinary ScriptMode = C.CAIRO_SCRIPT_MODE_BINARY
+)
+
+// String implements the Stringer interface, which is used in places like fmt's %q.  For all enums like this it returns the Go name of the constant.
+func (i ScriptMode) String() string {
+	switch i {
+	case ScriptModeASCII:
+		return "ScriptModeASCII"
+	case ScriptModeBinary:
+		return "ScriptModeBinary"
+	default:
+		return fmt.Sprintf("ScriptMode(%d)", i)
+	}
+}
+
+// See cairo_script_create().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-create
+func ScriptCreate(filename string) *ScriptDevice {
+	c_filename := C.CString(filename)
+	defer C.free(unsafe.Pointer(c_filename))
+	ret := &ScriptDevice{wrapDevice(C.cairo_script_create(c_filename))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_script_create_for_stream().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-create-for-stream
+func ScriptCreateForStream(w io.Writer) *ScriptDevice {
+	key := newStreamKey(w)
+	dev := C.cairo_script_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key))
+	attachDeviceStreamKey(dev, key)
+	ret := &ScriptDevice{wrapDevice(dev)}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_script_write_comment().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-write-comment
+func (script *ScriptDevice) WriteComment(comment string) {
+	if script.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	c_comment := C.CString(comment)
+	defer C.free(unsafe.Pointer(c_comment))
+	C.cairo_script_write_comment(script.Ptr, c_comment, C.int(len(comment)))
+	if err := script.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_script_set_mode().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-set-mode
+func (script *ScriptDevice) SetMode(mode ScriptMode) {
+	if script.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_script_set_mode(script.Ptr, C.cairo_script_mode_t(mode))
+	if err := script.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_script_get_mode().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-get-mode
+func (script *ScriptDevice) GetMode() ScriptMode {
+	if script.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := ScriptMode(C.cairo_script_get_mode(script.Ptr))
+	if err := script.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_script_surface_create().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-surface-create
+func ScriptSurfaceCreate(script *Device, content Content, width, height float64) *ScriptSurface {
+	if script.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := &ScriptSurface{wrapSurface(C.cairo_script_surface_create(script.Ptr, C.cairo_content_t(content), C.double(width), C.double(height)))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_script_surface_create_for_target().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-surface-create-for-target
+func ScriptSurfaceCreateForTarget(script *Device, target *Surface) *ScriptSurface {
+	if script.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if target.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := &ScriptSurface{wrapSurface(C.cairo_script_surface_create_for_target(script.Ptr, target.Ptr))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_script_from_recording_surface().
+//
+// C API documentation: http://cairographics.org/manual/cairo-Script-Surfaces.html#cairo-script-from-recording-surface
+func (script *ScriptDevice) FromRecordingSurface(recordingSurface *Surface) error {
+	if script.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if recordingSurface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := Status(C.cairo_script_from_recording_surface(script.Ptr, recordingSurface.Ptr)).toError()
+	if err := script.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_tee_surface_create().
+func TeeSurfaceCreate(primary *Surface) *TeeSurface {
+	if primary.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := &TeeSurface{wrapSurface(C.cairo_tee_surface_create(primary.Ptr))}
+	if err := ret.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// See cairo_tee_surface_add().
+func (surface *TeeSurface) Add(target *Surface) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if target.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_tee_surface_add(surface.Ptr, target.Ptr)
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_tee_surface_remove().
+func (surface *TeeSurface) Remove(target *Surface) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	if target.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	C.cairo_tee_surface_remove(surface.Ptr, target.Ptr)
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+}
+
+// See cairo_tee_surface_index().
+func (surface *TeeSurface) Index(index int) *Surface {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	ret := wrapSurface(C.cairo_surface_reference(C.cairo_tee_surface_index(surface.Ptr, C.uint(index))))
+	if err := surface.status(); err != nil {
+		panic(err)
+	}
+	return ret
+}
@@ -0,0 +1,5068 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Autogenerated by gen.go -checked, do not edit.
+
+package checked
+
+import (
+	"image"
+	"image/color"
+	"io"
+	"unsafe"
+
+	"github.com/martine/gocairo/cairo"
+)
+
+// ImageSurface wraps a *cairo.ImageSurface.  Its methods return errors instead of panicking.
+type ImageSurface struct {
+	*cairo.ImageSurface
+}
+
+// RecordingSurface wraps a *cairo.RecordingSurface.  Its methods return errors instead of panicking.
+type RecordingSurface struct {
+	*cairo.RecordingSurface
+}
+
+// SurfaceObserver wraps a *cairo.SurfaceObserver.  Its methods return errors instead of panicking.
+type SurfaceObserver struct {
+	*cairo.SurfaceObserver
+}
+
+// ToyFontFace wraps a *cairo.ToyFontFace.  Its methods return errors instead of panicking.
+type ToyFontFace struct {
+	*cairo.ToyFontFace
+}
+
+// FTFontFace wraps a *cairo.FTFontFace.  Its methods return errors instead of panicking.
+type FTFontFace struct {
+	*cairo.FTFontFace
+}
+
+// FTScaledFont wraps a *cairo.FTScaledFont.  Its methods return errors instead of panicking.
+type FTScaledFont struct {
+	*cairo.FTScaledFont
+}
+
+// UserFontFace wraps a *cairo.UserFontFace.  Its methods return errors instead of panicking.
+type UserFontFace struct {
+	*cairo.UserFontFace
+}
+
+// MeshPattern wraps a *cairo.MeshPattern.  Its methods return errors instead of panicking.
+type MeshPattern struct {
+	*cairo.MeshPattern
+}
+
+// RasterSourcePattern wraps a *cairo.RasterSourcePattern.  Its methods return errors instead of panicking.
+type RasterSourcePattern struct {
+	*cairo.RasterSourcePattern
+}
+
+// LinearGradient wraps a *cairo.LinearGradient.  Its methods return errors instead of panicking.
+type LinearGradient struct {
+	*cairo.LinearGradient
+}
+
+// RadialGradient wraps a *cairo.RadialGradient.  Its methods return errors instead of panicking.
+type RadialGradient struct {
+	*cairo.RadialGradient
+}
+
+// SurfacePattern wraps a *cairo.SurfacePattern.  Its methods return errors instead of panicking.
+type SurfacePattern struct {
+	*cairo.SurfacePattern
+}
+
+// SolidPattern wraps a *cairo.SolidPattern.  Its methods return errors instead of panicking.
+type SolidPattern struct {
+	*cairo.SolidPattern
+}
+
+// PDFSurface wraps a *cairo.PDFSurface.  Its methods return errors instead of panicking.
+type PDFSurface struct {
+	*cairo.PDFSurface
+}
+
+// PSSurface wraps a *cairo.PSSurface.  Its methods return errors instead of panicking.
+type PSSurface struct {
+	*cairo.PSSurface
+}
+
+// SVGSurface wraps a *cairo.SVGSurface.  Its methods return errors instead of panicking.
+type SVGSurface struct {
+	*cairo.SVGSurface
+}
+
+// XlibSurface wraps a *cairo.XlibSurface.  Its methods return errors instead of panicking.
+type XlibSurface struct {
+	*cairo.XlibSurface
+}
+
+// XlibDevice wraps a *cairo.XlibDevice.  Its methods return errors instead of panicking.
+type XlibDevice struct {
+	*cairo.XlibDevice
+}
+
+// ScriptSurface wraps a *cairo.ScriptSurface.  Its methods return errors instead of panicking.
+type ScriptSurface struct {
+	*cairo.ScriptSurface
+}
+
+// ScriptDevice wraps a *cairo.ScriptDevice.  Its methods return errors instead of panicking.
+type ScriptDevice struct {
+	*cairo.ScriptDevice
+}
+
+// TeeSurface wraps a *cairo.TeeSurface.  Its methods return errors instead of panicking.
+type TeeSurface struct {
+	*cairo.TeeSurface
+}
+
+// Context wraps a *cairo.Context.  Its methods return errors instead of panicking.
+type Context struct {
+	*cairo.Context
+}
+
+// Surface wraps a *cairo.Surface.  Its methods return errors instead of panicking.
+type Surface struct {
+	*cairo.Surface
+}
+
+// Device wraps a *cairo.Device.  Its methods return errors instead of panicking.
+type Device struct {
+	*cairo.Device
+}
+
+// Pattern wraps a *cairo.Pattern.  Its methods return errors instead of panicking.
+type Pattern struct {
+	*cairo.Pattern
+}
+
+// ScaledFont wraps a *cairo.ScaledFont.  Its methods return errors instead of panicking.
+type ScaledFont struct {
+	*cairo.ScaledFont
+}
+
+// FontFace wraps a *cairo.FontFace.  Its methods return errors instead of panicking.
+type FontFace struct {
+	*cairo.FontFace
+}
+
+// FontOptions wraps a *cairo.FontOptions.  Its methods return errors instead of panicking.
+type FontOptions struct {
+	*cairo.FontOptions
+}
+
+// Path wraps a *cairo.Path.  Its methods return errors instead of panicking.
+type Path struct {
+	*cairo.Path
+}
+
+// Region wraps a *cairo.Region.  Its methods return errors instead of panicking.
+type Region struct {
+	*cairo.Region
+}
+
+// FcPattern wraps a *cairo.FcPattern.  Its methods return errors instead of panicking.
+type FcPattern struct {
+	*cairo.FcPattern
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface Surface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.Surface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface ImageSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.ImageSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface RecordingSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.RecordingSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface SurfaceObserver) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.SurfaceObserver.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface PDFSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.PDFSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface PSSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.PSSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface SVGSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.SVGSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface XlibSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.XlibSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface ScriptSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.ScriptSurface.WriteToPNG(w)
+}
+
+// See cairo.Surface.WriteToPNG.
+func (surface TeeSurface) WriteToPNG(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.TeeSurface.WriteToPNG(w)
+}
+
+// See cairo.Path.Iter.
+func (p Path) Iter() (_ *cairo.PathIter, err error) {
+	defer catch(&err)
+	r0 := p.Path.Iter()
+	return r0, nil
+}
+
+// See cairo.FontFace.ToFTFontFace.
+func (fontFace FontFace) ToFTFontFace() (_ FTFontFace, err error) {
+	defer catch(&err)
+	r0, e := fontFace.FontFace.ToFTFontFace()
+	return FTFontFace{r0}, e
+}
+
+// See cairo.FontFace.ToFTFontFace.
+func (fontFace ToyFontFace) ToFTFontFace() (_ FTFontFace, err error) {
+	defer catch(&err)
+	r0, e := fontFace.ToyFontFace.ToFTFontFace()
+	return FTFontFace{r0}, e
+}
+
+// See cairo.FontFace.ToFTFontFace.
+func (fontFace FTFontFace) ToFTFontFace() (_ FTFontFace, err error) {
+	defer catch(&err)
+	r0, e := fontFace.FTFontFace.ToFTFontFace()
+	return FTFontFace{r0}, e
+}
+
+// See cairo.FontFace.ToFTFontFace.
+func (fontFace UserFontFace) ToFTFontFace() (_ FTFontFace, err error) {
+	defer catch(&err)
+	r0, e := fontFace.UserFontFace.ToFTFontFace()
+	return FTFontFace{r0}, e
+}
+
+// See cairo.ScaledFont.ToFTScaledFont.
+func (scaledFont ScaledFont) ToFTScaledFont() (_ FTScaledFont, err error) {
+	defer catch(&err)
+	r0, e := scaledFont.ScaledFont.ToFTScaledFont()
+	return FTScaledFont{r0}, e
+}
+
+// See cairo.ScaledFont.ToFTScaledFont.
+func (scaledFont FTScaledFont) ToFTScaledFont() (_ FTScaledFont, err error) {
+	defer catch(&err)
+	r0, e := scaledFont.FTScaledFont.ToFTScaledFont()
+	return FTScaledFont{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern Pattern) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.Pattern.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern MeshPattern) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern RasterSourcePattern) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RasterSourcePattern.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern LinearGradient) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern RadialGradient) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern SurfacePattern) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToMeshPattern.
+func (pattern SolidPattern) ToMeshPattern() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SolidPattern.ToMeshPattern()
+	return MeshPattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern Pattern) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.Pattern.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern MeshPattern) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern RasterSourcePattern) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RasterSourcePattern.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern LinearGradient) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern RadialGradient) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern SurfacePattern) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToRasterSourcePattern.
+func (pattern SolidPattern) ToRasterSourcePattern() (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SolidPattern.ToRasterSourcePattern()
+	return RasterSourcePattern{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern Pattern) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.Pattern.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern MeshPattern) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern RasterSourcePattern) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.RasterSourcePattern.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern LinearGradient) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern RadialGradient) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern SurfacePattern) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToLinearGradient.
+func (pattern SolidPattern) ToLinearGradient() (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.SolidPattern.ToLinearGradient()
+	return LinearGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern Pattern) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.Pattern.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern MeshPattern) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern RasterSourcePattern) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.RasterSourcePattern.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern LinearGradient) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern RadialGradient) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern SurfacePattern) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToRadialGradient.
+func (pattern SolidPattern) ToRadialGradient() (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0, e := pattern.SolidPattern.ToRadialGradient()
+	return RadialGradient{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern Pattern) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.Pattern.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern MeshPattern) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern RasterSourcePattern) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RasterSourcePattern.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern LinearGradient) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern RadialGradient) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern SurfacePattern) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSurfacePattern.
+func (pattern SolidPattern) ToSurfacePattern() (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SolidPattern.ToSurfacePattern()
+	return SurfacePattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern Pattern) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.Pattern.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern MeshPattern) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern RasterSourcePattern) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RasterSourcePattern.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern LinearGradient) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern RadialGradient) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern SurfacePattern) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Pattern.ToSolidPattern.
+func (pattern SolidPattern) ToSolidPattern() (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0, e := pattern.SolidPattern.ToSolidPattern()
+	return SolidPattern{r0}, e
+}
+
+// See cairo.Create.
+func Create(target *cairo.Surface) (_ Context, err error) {
+	defer catch(&err)
+	r0 := cairo.Create(target)
+	return Context{r0}, nil
+}
+
+// See cairo.Context.Save.
+func (cr Context) Save() (err error) {
+	defer catch(&err)
+	cr.Context.Save()
+	return nil
+}
+
+// See cairo.Context.Restore.
+func (cr Context) Restore() (err error) {
+	defer catch(&err)
+	cr.Context.Restore()
+	return nil
+}
+
+// See cairo.Context.PushGroup.
+func (cr Context) PushGroup() (err error) {
+	defer catch(&err)
+	cr.Context.PushGroup()
+	return nil
+}
+
+// See cairo.Context.PushGroupWithContent.
+func (cr Context) PushGroupWithContent(content cairo.Content) (err error) {
+	defer catch(&err)
+	cr.Context.PushGroupWithContent(content)
+	return nil
+}
+
+// See cairo.Context.PopGroup.
+func (cr Context) PopGroup() (_ Pattern, err error) {
+	defer catch(&err)
+	r0 := cr.Context.PopGroup()
+	return Pattern{r0}, nil
+}
+
+// See cairo.Context.PopGroupToSource.
+func (cr Context) PopGroupToSource() (err error) {
+	defer catch(&err)
+	cr.Context.PopGroupToSource()
+	return nil
+}
+
+// See cairo.Context.SetOperator.
+func (cr Context) SetOperator(op cairo.Operator) (err error) {
+	defer catch(&err)
+	cr.Context.SetOperator(op)
+	return nil
+}
+
+// See cairo.Context.SetSource.
+func (cr Context) SetSource(source *cairo.Pattern) (err error) {
+	defer catch(&err)
+	cr.Context.SetSource(source)
+	return nil
+}
+
+// See cairo.Context.SetSourceRGB.
+func (cr Context) SetSourceRGB(red, green, blue float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetSourceRGB(red, green, blue)
+	return nil
+}
+
+// See cairo.Context.SetSourceRGBA.
+func (cr Context) SetSourceRGBA(red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetSourceRGBA(red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Context.SetSourceSurface.
+func (cr Context) SetSourceSurface(surface *cairo.Surface, x, y float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetSourceSurface(surface, x, y)
+	return nil
+}
+
+// See cairo.Context.SetTolerance.
+func (cr Context) SetTolerance(tolerance float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetTolerance(tolerance)
+	return nil
+}
+
+// See cairo.Context.SetAntialias.
+func (cr Context) SetAntialias(antialias cairo.Antialias) (err error) {
+	defer catch(&err)
+	cr.Context.SetAntialias(antialias)
+	return nil
+}
+
+// See cairo.Context.SetFillRule.
+func (cr Context) SetFillRule(fillRule cairo.FillRule) (err error) {
+	defer catch(&err)
+	cr.Context.SetFillRule(fillRule)
+	return nil
+}
+
+// See cairo.Context.SetLineWidth.
+func (cr Context) SetLineWidth(width float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetLineWidth(width)
+	return nil
+}
+
+// See cairo.Context.SetLineCap.
+func (cr Context) SetLineCap(lineCap cairo.LineCap) (err error) {
+	defer catch(&err)
+	cr.Context.SetLineCap(lineCap)
+	return nil
+}
+
+// See cairo.Context.SetLineJoin.
+func (cr Context) SetLineJoin(lineJoin cairo.LineJoin) (err error) {
+	defer catch(&err)
+	cr.Context.SetLineJoin(lineJoin)
+	return nil
+}
+
+// See cairo.Context.SetDash.
+func (cr Context) SetDash(dashes []float64, offset float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetDash(dashes, offset)
+	return nil
+}
+
+// See cairo.Context.SetMiterLimit.
+func (cr Context) SetMiterLimit(limit float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetMiterLimit(limit)
+	return nil
+}
+
+// See cairo.Context.Translate.
+func (cr Context) Translate(tx, ty float64) (err error) {
+	defer catch(&err)
+	cr.Context.Translate(tx, ty)
+	return nil
+}
+
+// See cairo.Context.Scale.
+func (cr Context) Scale(sx, sy float64) (err error) {
+	defer catch(&err)
+	cr.Context.Scale(sx, sy)
+	return nil
+}
+
+// See cairo.Context.Rotate.
+func (cr Context) Rotate(angle float64) (err error) {
+	defer catch(&err)
+	cr.Context.Rotate(angle)
+	return nil
+}
+
+// See cairo.Context.Transform.
+func (cr Context) Transform(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	cr.Context.Transform(matrix)
+	return nil
+}
+
+// See cairo.Context.SetMatrix.
+func (cr Context) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	cr.Context.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Context.IdentityMatrix.
+func (cr Context) IdentityMatrix() (err error) {
+	defer catch(&err)
+	cr.Context.IdentityMatrix()
+	return nil
+}
+
+// See cairo.Context.UserToDevice.
+func (cr Context) UserToDevice(x, y *float64) (err error) {
+	defer catch(&err)
+	cr.Context.UserToDevice(x, y)
+	return nil
+}
+
+// See cairo.Context.UserToDeviceDistance.
+func (cr Context) UserToDeviceDistance(dx, dy *float64) (err error) {
+	defer catch(&err)
+	cr.Context.UserToDeviceDistance(dx, dy)
+	return nil
+}
+
+// See cairo.Context.DeviceToUser.
+func (cr Context) DeviceToUser(x, y *float64) (err error) {
+	defer catch(&err)
+	cr.Context.DeviceToUser(x, y)
+	return nil
+}
+
+// See cairo.Context.DeviceToUserDistance.
+func (cr Context) DeviceToUserDistance(dx, dy *float64) (err error) {
+	defer catch(&err)
+	cr.Context.DeviceToUserDistance(dx, dy)
+	return nil
+}
+
+// See cairo.Context.NewPath.
+func (cr Context) NewPath() (err error) {
+	defer catch(&err)
+	cr.Context.NewPath()
+	return nil
+}
+
+// See cairo.Context.MoveTo.
+func (cr Context) MoveTo(x, y float64) (err error) {
+	defer catch(&err)
+	cr.Context.MoveTo(x, y)
+	return nil
+}
+
+// See cairo.Context.NewSubPath.
+func (cr Context) NewSubPath() (err error) {
+	defer catch(&err)
+	cr.Context.NewSubPath()
+	return nil
+}
+
+// See cairo.Context.LineTo.
+func (cr Context) LineTo(x, y float64) (err error) {
+	defer catch(&err)
+	cr.Context.LineTo(x, y)
+	return nil
+}
+
+// See cairo.Context.CurveTo.
+func (cr Context) CurveTo(x1, y1, x2, y2, x3, y3 float64) (err error) {
+	defer catch(&err)
+	cr.Context.CurveTo(x1, y1, x2, y2, x3, y3)
+	return nil
+}
+
+// See cairo.Context.Arc.
+func (cr Context) Arc(xc, yc, radius, angle1, angle2 float64) (err error) {
+	defer catch(&err)
+	cr.Context.Arc(xc, yc, radius, angle1, angle2)
+	return nil
+}
+
+// See cairo.Context.ArcNegative.
+func (cr Context) ArcNegative(xc, yc, radius, angle1, angle2 float64) (err error) {
+	defer catch(&err)
+	cr.Context.ArcNegative(xc, yc, radius, angle1, angle2)
+	return nil
+}
+
+// See cairo.Context.RelMoveTo.
+func (cr Context) RelMoveTo(dx, dy float64) (err error) {
+	defer catch(&err)
+	cr.Context.RelMoveTo(dx, dy)
+	return nil
+}
+
+// See cairo.Context.RelLineTo.
+func (cr Context) RelLineTo(dx, dy float64) (err error) {
+	defer catch(&err)
+	cr.Context.RelLineTo(dx, dy)
+	return nil
+}
+
+// See cairo.Context.RelCurveTo.
+func (cr Context) RelCurveTo(dx1, dy1, dx2, dy2, dx3, dy3 float64) (err error) {
+	defer catch(&err)
+	cr.Context.RelCurveTo(dx1, dy1, dx2, dy2, dx3, dy3)
+	return nil
+}
+
+// See cairo.Context.Rectangle.
+func (cr Context) Rectangle(x, y, width, height float64) (err error) {
+	defer catch(&err)
+	cr.Context.Rectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Context.ClosePath.
+func (cr Context) ClosePath() (err error) {
+	defer catch(&err)
+	cr.Context.ClosePath()
+	return nil
+}
+
+// See cairo.Context.PathExtents.
+func (cr Context) PathExtents() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3 := cr.Context.PathExtents()
+	return r0, r1, r2, r3, nil
+}
+
+// See cairo.Context.Paint.
+func (cr Context) Paint() (err error) {
+	defer catch(&err)
+	cr.Context.Paint()
+	return nil
+}
+
+// See cairo.Context.PaintWithAlpha.
+func (cr Context) PaintWithAlpha(alpha float64) (err error) {
+	defer catch(&err)
+	cr.Context.PaintWithAlpha(alpha)
+	return nil
+}
+
+// See cairo.Context.Mask.
+func (cr Context) Mask(pattern *cairo.Pattern) (err error) {
+	defer catch(&err)
+	cr.Context.Mask(pattern)
+	return nil
+}
+
+// See cairo.Context.MaskSurface.
+func (cr Context) MaskSurface(surface *cairo.Surface, surfaceX, surfaceY float64) (err error) {
+	defer catch(&err)
+	cr.Context.MaskSurface(surface, surfaceX, surfaceY)
+	return nil
+}
+
+// See cairo.Context.Stroke.
+func (cr Context) Stroke() (err error) {
+	defer catch(&err)
+	cr.Context.Stroke()
+	return nil
+}
+
+// See cairo.Context.StrokePreserve.
+func (cr Context) StrokePreserve() (err error) {
+	defer catch(&err)
+	cr.Context.StrokePreserve()
+	return nil
+}
+
+// See cairo.Context.Fill.
+func (cr Context) Fill() (err error) {
+	defer catch(&err)
+	cr.Context.Fill()
+	return nil
+}
+
+// See cairo.Context.FillPreserve.
+func (cr Context) FillPreserve() (err error) {
+	defer catch(&err)
+	cr.Context.FillPreserve()
+	return nil
+}
+
+// See cairo.Context.CopyPage.
+func (cr Context) CopyPage() (err error) {
+	defer catch(&err)
+	cr.Context.CopyPage()
+	return nil
+}
+
+// See cairo.Context.ShowPage.
+func (cr Context) ShowPage() (err error) {
+	defer catch(&err)
+	cr.Context.ShowPage()
+	return nil
+}
+
+// See cairo.Context.InStroke.
+func (cr Context) InStroke(x, y float64) (_ bool, err error) {
+	defer catch(&err)
+	r0 := cr.Context.InStroke(x, y)
+	return r0, nil
+}
+
+// See cairo.Context.InFill.
+func (cr Context) InFill(x, y float64) (_ bool, err error) {
+	defer catch(&err)
+	r0 := cr.Context.InFill(x, y)
+	return r0, nil
+}
+
+// See cairo.Context.InClip.
+func (cr Context) InClip(x, y float64) (_ bool, err error) {
+	defer catch(&err)
+	r0 := cr.Context.InClip(x, y)
+	return r0, nil
+}
+
+// See cairo.Context.StrokeExtents.
+func (cr Context) StrokeExtents() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3 := cr.Context.StrokeExtents()
+	return r0, r1, r2, r3, nil
+}
+
+// See cairo.Context.FillExtents.
+func (cr Context) FillExtents() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3 := cr.Context.FillExtents()
+	return r0, r1, r2, r3, nil
+}
+
+// See cairo.Context.ResetClip.
+func (cr Context) ResetClip() (err error) {
+	defer catch(&err)
+	cr.Context.ResetClip()
+	return nil
+}
+
+// See cairo.Context.Clip.
+func (cr Context) Clip() (err error) {
+	defer catch(&err)
+	cr.Context.Clip()
+	return nil
+}
+
+// See cairo.Context.ClipPreserve.
+func (cr Context) ClipPreserve() (err error) {
+	defer catch(&err)
+	cr.Context.ClipPreserve()
+	return nil
+}
+
+// See cairo.Context.ClipExtents.
+func (cr Context) ClipExtents() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3 := cr.Context.ClipExtents()
+	return r0, r1, r2, r3, nil
+}
+
+// See cairo.Context.ClipRectangles.
+func (cr Context) ClipRectangles() (_ []cairo.Rectangle, err error) {
+	defer catch(&err)
+	r0, e := cr.Context.ClipRectangles()
+	return r0, e
+}
+
+// See cairo.Context.TagBegin.
+func (cr Context) TagBegin(tagName string, attrs cairo.TagAttributes) (err error) {
+	defer catch(&err)
+	cr.Context.TagBegin(tagName, attrs)
+	return nil
+}
+
+// See cairo.Context.TagEnd.
+func (cr Context) TagEnd(tagName string) (err error) {
+	defer catch(&err)
+	cr.Context.TagEnd(tagName)
+	return nil
+}
+
+// See cairo.FontOptionsCreate.
+func FontOptionsCreate() (_ FontOptions, err error) {
+	defer catch(&err)
+	r0 := cairo.FontOptionsCreate()
+	return FontOptions{r0}, nil
+}
+
+// See cairo.FontOptions.Copy.
+func (original FontOptions) Copy() (_ FontOptions, err error) {
+	defer catch(&err)
+	r0 := original.FontOptions.Copy()
+	return FontOptions{r0}, nil
+}
+
+// See cairo.FontOptions.Merge.
+func (options FontOptions) Merge(other *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	options.FontOptions.Merge(other)
+	return nil
+}
+
+// See cairo.FontOptions.Equal.
+func (options FontOptions) Equal(other *cairo.FontOptions) (_ bool, err error) {
+	defer catch(&err)
+	r0 := options.FontOptions.Equal(other)
+	return r0, nil
+}
+
+// See cairo.FontOptions.Hash.
+func (options FontOptions) Hash() (_ uint32, err error) {
+	defer catch(&err)
+	r0 := options.FontOptions.Hash()
+	return r0, nil
+}
+
+// See cairo.FontOptions.SetAntialias.
+func (options FontOptions) SetAntialias(antialias cairo.Antialias) (err error) {
+	defer catch(&err)
+	options.FontOptions.SetAntialias(antialias)
+	return nil
+}
+
+// See cairo.FontOptions.GetAntialias.
+func (options FontOptions) GetAntialias() (_ cairo.Antialias, err error) {
+	defer catch(&err)
+	r0 := options.FontOptions.GetAntialias()
+	return r0, nil
+}
+
+// See cairo.FontOptions.SetSubpixelOrder.
+func (options FontOptions) SetSubpixelOrder(subpixelOrder cairo.SubpixelOrder) (err error) {
+	defer catch(&err)
+	options.FontOptions.SetSubpixelOrder(subpixelOrder)
+	return nil
+}
+
+// See cairo.FontOptions.GetSubpixelOrder.
+func (options FontOptions) GetSubpixelOrder() (_ cairo.SubpixelOrder, err error) {
+	defer catch(&err)
+	r0 := options.FontOptions.GetSubpixelOrder()
+	return r0, nil
+}
+
+// See cairo.FontOptions.SetHintStyle.
+func (options FontOptions) SetHintStyle(hintStyle cairo.HintStyle) (err error) {
+	defer catch(&err)
+	options.FontOptions.SetHintStyle(hintStyle)
+	return nil
+}
+
+// See cairo.FontOptions.GetHintStyle.
+func (options FontOptions) GetHintStyle() (_ cairo.HintStyle, err error) {
+	defer catch(&err)
+	r0 := options.FontOptions.GetHintStyle()
+	return r0, nil
+}
+
+// See cairo.FontOptions.SetHintMetrics.
+func (options FontOptions) SetHintMetrics(hintMetrics cairo.HintMetrics) (err error) {
+	defer catch(&err)
+	options.FontOptions.SetHintMetrics(hintMetrics)
+	return nil
+}
+
+// See cairo.FontOptions.GetHintMetrics.
+func (options FontOptions) GetHintMetrics() (_ cairo.HintMetrics, err error) {
+	defer catch(&err)
+	r0 := options.FontOptions.GetHintMetrics()
+	return r0, nil
+}
+
+// See cairo.Context.SelectFontFace.
+func (cr Context) SelectFontFace(family string, slant cairo.FontSlant, weight cairo.FontWeight) (err error) {
+	defer catch(&err)
+	cr.Context.SelectFontFace(family, slant, weight)
+	return nil
+}
+
+// See cairo.Context.SetFontSize.
+func (cr Context) SetFontSize(size float64) (err error) {
+	defer catch(&err)
+	cr.Context.SetFontSize(size)
+	return nil
+}
+
+// See cairo.Context.SetFontMatrix.
+func (cr Context) SetFontMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	cr.Context.SetFontMatrix(matrix)
+	return nil
+}
+
+// See cairo.Context.GetFontMatrix.
+func (cr Context) GetFontMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	cr.Context.GetFontMatrix(matrix)
+	return nil
+}
+
+// See cairo.Context.SetFontOptions.
+func (cr Context) SetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	cr.Context.SetFontOptions(options)
+	return nil
+}
+
+// See cairo.Context.GetFontOptions.
+func (cr Context) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	cr.Context.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Context.SetFontFace.
+func (cr Context) SetFontFace(fontFace *cairo.FontFace) (err error) {
+	defer catch(&err)
+	cr.Context.SetFontFace(fontFace)
+	return nil
+}
+
+// See cairo.Context.GetFontFace.
+func (cr Context) GetFontFace() (_ FontFace, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetFontFace()
+	return FontFace{r0}, nil
+}
+
+// See cairo.Context.SetScaledFont.
+func (cr Context) SetScaledFont(scaledFont *cairo.ScaledFont) (err error) {
+	defer catch(&err)
+	cr.Context.SetScaledFont(scaledFont)
+	return nil
+}
+
+// See cairo.Context.GetScaledFont.
+func (cr Context) GetScaledFont() (_ ScaledFont, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetScaledFont()
+	return ScaledFont{r0}, nil
+}
+
+// See cairo.Context.ShowText.
+func (cr Context) ShowText(utf8 string) (err error) {
+	defer catch(&err)
+	cr.Context.ShowText(utf8)
+	return nil
+}
+
+// See cairo.Context.ShowGlyphs.
+func (cr Context) ShowGlyphs(glyphs []cairo.Glyph) (err error) {
+	defer catch(&err)
+	cr.Context.ShowGlyphs(glyphs)
+	return nil
+}
+
+// See cairo.Context.ShowTextGlyphs.
+func (cr Context) ShowTextGlyphs(utf8 string, glyphs []cairo.Glyph, clusters []cairo.TextCluster, clusterFlags cairo.TextClusterFlags) (err error) {
+	defer catch(&err)
+	cr.Context.ShowTextGlyphs(utf8, glyphs, clusters, clusterFlags)
+	return nil
+}
+
+// See cairo.Context.TextPath.
+func (cr Context) TextPath(utf8 string) (err error) {
+	defer catch(&err)
+	cr.Context.TextPath(utf8)
+	return nil
+}
+
+// See cairo.Context.GlyphPath.
+func (cr Context) GlyphPath(glyphs []cairo.Glyph) (err error) {
+	defer catch(&err)
+	cr.Context.GlyphPath(glyphs)
+	return nil
+}
+
+// See cairo.Context.TextExtents.
+func (cr Context) TextExtents(utf8 string, extents *cairo.TextExtents) (err error) {
+	defer catch(&err)
+	cr.Context.TextExtents(utf8, extents)
+	return nil
+}
+
+// See cairo.Context.GlyphExtents.
+func (cr Context) GlyphExtents(glyphs []cairo.Glyph, extents *cairo.TextExtents) (err error) {
+	defer catch(&err)
+	cr.Context.GlyphExtents(glyphs, extents)
+	return nil
+}
+
+// See cairo.Context.FontExtents.
+func (cr Context) FontExtents(extents *cairo.FontExtents) (err error) {
+	defer catch(&err)
+	cr.Context.FontExtents(extents)
+	return nil
+}
+
+// See cairo.FontFace.GetType.
+func (fontFace FontFace) GetType() (_ cairo.FontType, err error) {
+	defer catch(&err)
+	r0 := fontFace.FontFace.GetType()
+	return r0, nil
+}
+
+// See cairo.FontFace.GetType.
+func (fontFace ToyFontFace) GetType() (_ cairo.FontType, err error) {
+	defer catch(&err)
+	r0 := fontFace.ToyFontFace.GetType()
+	return r0, nil
+}
+
+// See cairo.FontFace.GetType.
+func (fontFace FTFontFace) GetType() (_ cairo.FontType, err error) {
+	defer catch(&err)
+	r0 := fontFace.FTFontFace.GetType()
+	return r0, nil
+}
+
+// See cairo.FontFace.GetType.
+func (fontFace UserFontFace) GetType() (_ cairo.FontType, err error) {
+	defer catch(&err)
+	r0 := fontFace.UserFontFace.GetType()
+	return r0, nil
+}
+
+// See cairo.ScaledFontCreate.
+func ScaledFontCreate(fontFace *cairo.FontFace, fontMatrix, ctm *cairo.Matrix, options *cairo.FontOptions) (_ ScaledFont, err error) {
+	defer catch(&err)
+	r0 := cairo.ScaledFontCreate(fontFace, fontMatrix, ctm, options)
+	return ScaledFont{r0}, nil
+}
+
+// See cairo.ScaledFont.GetType.
+func (scaledFont ScaledFont) GetType() (_ cairo.FontType, err error) {
+	defer catch(&err)
+	r0 := scaledFont.ScaledFont.GetType()
+	return r0, nil
+}
+
+// See cairo.ScaledFont.GetType.
+func (scaledFont FTScaledFont) GetType() (_ cairo.FontType, err error) {
+	defer catch(&err)
+	r0 := scaledFont.FTScaledFont.GetType()
+	return r0, nil
+}
+
+// See cairo.ScaledFont.Extents.
+func (scaledFont ScaledFont) Extents(extents *cairo.FontExtents) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.Extents(extents)
+	return nil
+}
+
+// See cairo.ScaledFont.Extents.
+func (scaledFont FTScaledFont) Extents(extents *cairo.FontExtents) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.Extents(extents)
+	return nil
+}
+
+// See cairo.ScaledFont.TextExtents.
+func (scaledFont ScaledFont) TextExtents(utf8 string, extents *cairo.TextExtents) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.TextExtents(utf8, extents)
+	return nil
+}
+
+// See cairo.ScaledFont.TextExtents.
+func (scaledFont FTScaledFont) TextExtents(utf8 string, extents *cairo.TextExtents) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.TextExtents(utf8, extents)
+	return nil
+}
+
+// See cairo.ScaledFont.GlyphExtents.
+func (scaledFont ScaledFont) GlyphExtents(glyphs []cairo.Glyph, extents *cairo.TextExtents) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.GlyphExtents(glyphs, extents)
+	return nil
+}
+
+// See cairo.ScaledFont.GlyphExtents.
+func (scaledFont FTScaledFont) GlyphExtents(glyphs []cairo.Glyph, extents *cairo.TextExtents) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.GlyphExtents(glyphs, extents)
+	return nil
+}
+
+// See cairo.ScaledFont.TextToGlyphs.
+func (scaledFont ScaledFont) TextToGlyphs(x, y float64, utf8 string) (_ []cairo.Glyph, _ []cairo.TextCluster, _ cairo.TextClusterFlags, err error) {
+	defer catch(&err)
+	r0, r1, r2, e := scaledFont.ScaledFont.TextToGlyphs(x, y, utf8)
+	return r0, r1, r2, e
+}
+
+// See cairo.ScaledFont.TextToGlyphs.
+func (scaledFont FTScaledFont) TextToGlyphs(x, y float64, utf8 string) (_ []cairo.Glyph, _ []cairo.TextCluster, _ cairo.TextClusterFlags, err error) {
+	defer catch(&err)
+	r0, r1, r2, e := scaledFont.FTScaledFont.TextToGlyphs(x, y, utf8)
+	return r0, r1, r2, e
+}
+
+// See cairo.ScaledFont.GetFontFace.
+func (scaledFont ScaledFont) GetFontFace() (_ FontFace, err error) {
+	defer catch(&err)
+	r0 := scaledFont.ScaledFont.GetFontFace()
+	return FontFace{r0}, nil
+}
+
+// See cairo.ScaledFont.GetFontFace.
+func (scaledFont FTScaledFont) GetFontFace() (_ FontFace, err error) {
+	defer catch(&err)
+	r0 := scaledFont.FTScaledFont.GetFontFace()
+	return FontFace{r0}, nil
+}
+
+// See cairo.ScaledFont.GetFontMatrix.
+func (scaledFont ScaledFont) GetFontMatrix(fontMatrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.GetFontMatrix(fontMatrix)
+	return nil
+}
+
+// See cairo.ScaledFont.GetFontMatrix.
+func (scaledFont FTScaledFont) GetFontMatrix(fontMatrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.GetFontMatrix(fontMatrix)
+	return nil
+}
+
+// See cairo.ScaledFont.GetCTM.
+func (scaledFont ScaledFont) GetCTM(ctm *cairo.Matrix) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.GetCTM(ctm)
+	return nil
+}
+
+// See cairo.ScaledFont.GetCTM.
+func (scaledFont FTScaledFont) GetCTM(ctm *cairo.Matrix) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.GetCTM(ctm)
+	return nil
+}
+
+// See cairo.ScaledFont.GetScaleMatrix.
+func (scaledFont ScaledFont) GetScaleMatrix(scaleMatrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.GetScaleMatrix(scaleMatrix)
+	return nil
+}
+
+// See cairo.ScaledFont.GetScaleMatrix.
+func (scaledFont FTScaledFont) GetScaleMatrix(scaleMatrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.GetScaleMatrix(scaleMatrix)
+	return nil
+}
+
+// See cairo.ScaledFont.GetFontOptions.
+func (scaledFont ScaledFont) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	scaledFont.ScaledFont.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.ScaledFont.GetFontOptions.
+func (scaledFont FTScaledFont) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.ToyFontFaceCreate.
+func ToyFontFaceCreate(family string, slant cairo.FontSlant, weight cairo.FontWeight) (_ ToyFontFace, err error) {
+	defer catch(&err)
+	r0 := cairo.ToyFontFaceCreate(family, slant, weight)
+	return ToyFontFace{r0}, nil
+}
+
+// See cairo.ToyFontFace.GetFamily.
+func (fontFace ToyFontFace) GetFamily() (_ string, err error) {
+	defer catch(&err)
+	r0 := fontFace.ToyFontFace.GetFamily()
+	return r0, nil
+}
+
+// See cairo.ToyFontFace.GetSlant.
+func (fontFace ToyFontFace) GetSlant() (_ cairo.FontSlant, err error) {
+	defer catch(&err)
+	r0 := fontFace.ToyFontFace.GetSlant()
+	return r0, nil
+}
+
+// See cairo.ToyFontFace.GetWeight.
+func (fontFace ToyFontFace) GetWeight() (_ cairo.FontWeight, err error) {
+	defer catch(&err)
+	r0 := fontFace.ToyFontFace.GetWeight()
+	return r0, nil
+}
+
+// See cairo.UserFontFaceCreate.
+func UserFontFaceCreate(font cairo.UserFont) (_ UserFontFace, err error) {
+	defer catch(&err)
+	r0 := cairo.UserFontFaceCreate(font)
+	return UserFontFace{r0}, nil
+}
+
+// See cairo.Context.GetOperator.
+func (cr Context) GetOperator() (_ cairo.Operator, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetOperator()
+	return r0, nil
+}
+
+// See cairo.Context.GetSource.
+func (cr Context) GetSource() (_ Pattern, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetSource()
+	return Pattern{r0}, nil
+}
+
+// See cairo.Context.GetTolerance.
+func (cr Context) GetTolerance() (_ float64, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetTolerance()
+	return r0, nil
+}
+
+// See cairo.Context.GetAntialias.
+func (cr Context) GetAntialias() (_ cairo.Antialias, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetAntialias()
+	return r0, nil
+}
+
+// See cairo.Context.HasCurrentPoint.
+func (cr Context) HasCurrentPoint() (_ bool, err error) {
+	defer catch(&err)
+	r0 := cr.Context.HasCurrentPoint()
+	return r0, nil
+}
+
+// See cairo.Context.GetCurrentPoint.
+func (cr Context) GetCurrentPoint() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := cr.Context.GetCurrentPoint()
+	return r0, r1, nil
+}
+
+// See cairo.Context.GetFillRule.
+func (cr Context) GetFillRule() (_ cairo.FillRule, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetFillRule()
+	return r0, nil
+}
+
+// See cairo.Context.GetLineWidth.
+func (cr Context) GetLineWidth() (_ float64, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetLineWidth()
+	return r0, nil
+}
+
+// See cairo.Context.GetLineCap.
+func (cr Context) GetLineCap() (_ cairo.LineCap, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetLineCap()
+	return r0, nil
+}
+
+// See cairo.Context.GetLineJoin.
+func (cr Context) GetLineJoin() (_ cairo.LineJoin, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetLineJoin()
+	return r0, nil
+}
+
+// See cairo.Context.GetMiterLimit.
+func (cr Context) GetMiterLimit() (_ float64, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetMiterLimit()
+	return r0, nil
+}
+
+// See cairo.Context.GetDashCount.
+func (cr Context) GetDashCount() (_ int, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetDashCount()
+	return r0, nil
+}
+
+// See cairo.Context.GetDash.
+func (cr Context) GetDash(dashes, offset *float64) (err error) {
+	defer catch(&err)
+	cr.Context.GetDash(dashes, offset)
+	return nil
+}
+
+// See cairo.Context.GetMatrix.
+func (cr Context) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	cr.Context.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Context.GetTarget.
+func (cr Context) GetTarget() (_ Surface, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetTarget()
+	return Surface{r0}, nil
+}
+
+// See cairo.Context.GetGroupTarget.
+func (cr Context) GetGroupTarget() (_ Surface, err error) {
+	defer catch(&err)
+	r0 := cr.Context.GetGroupTarget()
+	return Surface{r0}, nil
+}
+
+// See cairo.Context.CopyPath.
+func (cr Context) CopyPath() (_ Path, err error) {
+	defer catch(&err)
+	r0 := cr.Context.CopyPath()
+	return Path{r0}, nil
+}
+
+// See cairo.Context.CopyPathFlat.
+func (cr Context) CopyPathFlat() (_ Path, err error) {
+	defer catch(&err)
+	r0 := cr.Context.CopyPathFlat()
+	return Path{r0}, nil
+}
+
+// See cairo.Context.AppendPath.
+func (cr Context) AppendPath(path *cairo.Path) (err error) {
+	defer catch(&err)
+	cr.Context.AppendPath(path)
+	return nil
+}
+
+// See cairo.Device.GetType.
+func (device Device) GetType() (_ cairo.DeviceType, err error) {
+	defer catch(&err)
+	r0 := device.Device.GetType()
+	return r0, nil
+}
+
+// See cairo.Device.GetType.
+func (device XlibDevice) GetType() (_ cairo.DeviceType, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.GetType()
+	return r0, nil
+}
+
+// See cairo.Device.GetType.
+func (device ScriptDevice) GetType() (_ cairo.DeviceType, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.GetType()
+	return r0, nil
+}
+
+// See cairo.Device.Acquire.
+func (device Device) Acquire() (err error) {
+	defer catch(&err)
+	return device.Device.Acquire()
+}
+
+// See cairo.Device.Acquire.
+func (device XlibDevice) Acquire() (err error) {
+	defer catch(&err)
+	return device.XlibDevice.Acquire()
+}
+
+// See cairo.Device.Acquire.
+func (device ScriptDevice) Acquire() (err error) {
+	defer catch(&err)
+	return device.ScriptDevice.Acquire()
+}
+
+// See cairo.Device.Release.
+func (device Device) Release() (err error) {
+	defer catch(&err)
+	device.Device.Release()
+	return nil
+}
+
+// See cairo.Device.Release.
+func (device XlibDevice) Release() (err error) {
+	defer catch(&err)
+	device.XlibDevice.Release()
+	return nil
+}
+
+// See cairo.Device.Release.
+func (device ScriptDevice) Release() (err error) {
+	defer catch(&err)
+	device.ScriptDevice.Release()
+	return nil
+}
+
+// See cairo.Device.Flush.
+func (device Device) Flush() (err error) {
+	defer catch(&err)
+	device.Device.Flush()
+	return nil
+}
+
+// See cairo.Device.Flush.
+func (device XlibDevice) Flush() (err error) {
+	defer catch(&err)
+	device.XlibDevice.Flush()
+	return nil
+}
+
+// See cairo.Device.Flush.
+func (device ScriptDevice) Flush() (err error) {
+	defer catch(&err)
+	device.ScriptDevice.Flush()
+	return nil
+}
+
+// See cairo.Device.Finish.
+func (device Device) Finish() (err error) {
+	defer catch(&err)
+	device.Device.Finish()
+	return nil
+}
+
+// See cairo.Device.Finish.
+func (device XlibDevice) Finish() (err error) {
+	defer catch(&err)
+	device.XlibDevice.Finish()
+	return nil
+}
+
+// See cairo.Device.Finish.
+func (device ScriptDevice) Finish() (err error) {
+	defer catch(&err)
+	device.ScriptDevice.Finish()
+	return nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other Surface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.Surface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other ImageSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.ImageSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other RecordingSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.RecordingSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other SurfaceObserver) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.SurfaceObserver.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other PDFSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.PDFSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other PSSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.PSSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other SVGSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.SVGSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other XlibSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.XlibSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other ScriptSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.ScriptSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilar.
+func (other TeeSurface) CreateSimilar(content cairo.Content, width, height int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := other.TeeSurface.CreateSimilar(content, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other Surface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.Surface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other ImageSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.ImageSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other RecordingSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.RecordingSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other SurfaceObserver) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.SurfaceObserver.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other PDFSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.PDFSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other PSSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.PSSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other SVGSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.SVGSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other XlibSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.XlibSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other ScriptSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.ScriptSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.CreateSimilarImage.
+func (other TeeSurface) CreateSimilarImage(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := other.TeeSurface.CreateSimilarImage(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface Surface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface ImageSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface RecordingSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface SurfaceObserver) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface PDFSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface PSSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface SVGSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface XlibSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface ScriptSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.MapToImage.
+func (surface TeeSurface) MapToImage(rect *image.Rectangle) (_ *cairo.MappedImage, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.MapToImage(rect)
+	return r0, nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface Surface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.Surface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface ImageSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface RecordingSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface SurfaceObserver) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface PDFSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface PSSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.PSSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface SVGSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface XlibSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface ScriptSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.UnmapImage.
+func (surface TeeSurface) UnmapImage(image *cairo.MappedImage) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.UnmapImage(image)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface Surface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.Surface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface ImageSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface RecordingSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface SurfaceObserver) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface PDFSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface PSSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.PSSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface SVGSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface XlibSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface ScriptSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.WithMappedImage.
+func (surface TeeSurface) WithMappedImage(rect *image.Rectangle, f func(*cairo.MappedImage)) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.WithMappedImage(rect, f)
+	return nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target Surface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.Surface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target ImageSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.ImageSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target RecordingSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.RecordingSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target SurfaceObserver) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.SurfaceObserver.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target PDFSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.PDFSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target PSSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.PSSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target SVGSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.SVGSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target XlibSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.XlibSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target ScriptSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.ScriptSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateForRectangle.
+func (target TeeSurface) CreateForRectangle(x, y, width, height float64) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := target.TeeSurface.CreateForRectangle(x, y, width, height)
+	return Surface{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target Surface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.Surface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target ImageSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.ImageSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target RecordingSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.RecordingSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target SurfaceObserver) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.SurfaceObserver.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target PDFSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.PDFSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target PSSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.PSSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target SVGSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.SVGSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target XlibSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.XlibSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target ScriptSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.ScriptSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.Surface.CreateObserver.
+func (target TeeSurface) CreateObserver(mode cairo.SurfaceObserverMode) (_ SurfaceObserver, err error) {
+	defer catch(&err)
+	r0 := target.TeeSurface.CreateObserver(mode)
+	return SurfaceObserver{r0}, nil
+}
+
+// See cairo.SurfaceObserver.AddPaintCallback.
+func (surface SurfaceObserver) AddPaintCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddPaintCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.AddMaskCallback.
+func (surface SurfaceObserver) AddMaskCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddMaskCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.AddFillCallback.
+func (surface SurfaceObserver) AddFillCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddFillCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.AddStrokeCallback.
+func (surface SurfaceObserver) AddStrokeCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddStrokeCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.AddGlyphsCallback.
+func (surface SurfaceObserver) AddGlyphsCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddGlyphsCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.AddFlushCallback.
+func (surface SurfaceObserver) AddFlushCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddFlushCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.AddFinishCallback.
+func (surface SurfaceObserver) AddFinishCallback(f cairo.SurfaceObserverCallback) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.AddFinishCallback(f)
+	return nil
+}
+
+// See cairo.SurfaceObserver.Print.
+func (surface SurfaceObserver) Print(w io.Writer) (err error) {
+	defer catch(&err)
+	return surface.SurfaceObserver.Print(w)
+}
+
+// See cairo.SurfaceObserver.Elapsed.
+func (surface SurfaceObserver) Elapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.Elapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverPrint.
+func (device Device) ObserverPrint(w io.Writer) (err error) {
+	defer catch(&err)
+	return device.Device.ObserverPrint(w)
+}
+
+// See cairo.Device.ObserverPrint.
+func (device XlibDevice) ObserverPrint(w io.Writer) (err error) {
+	defer catch(&err)
+	return device.XlibDevice.ObserverPrint(w)
+}
+
+// See cairo.Device.ObserverPrint.
+func (device ScriptDevice) ObserverPrint(w io.Writer) (err error) {
+	defer catch(&err)
+	return device.ScriptDevice.ObserverPrint(w)
+}
+
+// See cairo.Device.ObserverElapsed.
+func (device Device) ObserverElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.Device.ObserverElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverElapsed.
+func (device XlibDevice) ObserverElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.ObserverElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverElapsed.
+func (device ScriptDevice) ObserverElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.ObserverElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverPaintElapsed.
+func (device Device) ObserverPaintElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.Device.ObserverPaintElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverPaintElapsed.
+func (device XlibDevice) ObserverPaintElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.ObserverPaintElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverPaintElapsed.
+func (device ScriptDevice) ObserverPaintElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.ObserverPaintElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverMaskElapsed.
+func (device Device) ObserverMaskElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.Device.ObserverMaskElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverMaskElapsed.
+func (device XlibDevice) ObserverMaskElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.ObserverMaskElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverMaskElapsed.
+func (device ScriptDevice) ObserverMaskElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.ObserverMaskElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverFillElapsed.
+func (device Device) ObserverFillElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.Device.ObserverFillElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverFillElapsed.
+func (device XlibDevice) ObserverFillElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.ObserverFillElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverFillElapsed.
+func (device ScriptDevice) ObserverFillElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.ObserverFillElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverStrokeElapsed.
+func (device Device) ObserverStrokeElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.Device.ObserverStrokeElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverStrokeElapsed.
+func (device XlibDevice) ObserverStrokeElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.ObserverStrokeElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverStrokeElapsed.
+func (device ScriptDevice) ObserverStrokeElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.ObserverStrokeElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverGlyphsElapsed.
+func (device Device) ObserverGlyphsElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.Device.ObserverGlyphsElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverGlyphsElapsed.
+func (device XlibDevice) ObserverGlyphsElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.ObserverGlyphsElapsed()
+	return r0, nil
+}
+
+// See cairo.Device.ObserverGlyphsElapsed.
+func (device ScriptDevice) ObserverGlyphsElapsed() (_ float64, err error) {
+	defer catch(&err)
+	r0 := device.ScriptDevice.ObserverGlyphsElapsed()
+	return r0, nil
+}
+
+// See cairo.Surface.Finish.
+func (surface Surface) Finish() (err error) {
+	defer catch(&err)
+	surface.Surface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface ImageSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.ImageSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface RecordingSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface SurfaceObserver) Finish() (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface PDFSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.PDFSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface PSSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.PSSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface SVGSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.SVGSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface XlibSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.XlibSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface ScriptSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.Finish.
+func (surface TeeSurface) Finish() (err error) {
+	defer catch(&err)
+	surface.TeeSurface.Finish()
+	return nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface Surface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface ImageSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface RecordingSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface SurfaceObserver) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface PDFSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface PSSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface SVGSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface XlibSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface ScriptSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetDevice.
+func (surface TeeSurface) GetDevice() (_ Device, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.GetDevice()
+	return Device{r0}, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface Surface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface ImageSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface RecordingSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface SurfaceObserver) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface PDFSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface PSSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface SVGSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface XlibSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface ScriptSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetType.
+func (surface TeeSurface) GetType() (_ cairo.SurfaceType, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.GetType()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface Surface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface ImageSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface RecordingSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface SurfaceObserver) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface PDFSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface PSSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface SVGSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface XlibSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface ScriptSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.GetContent.
+func (surface TeeSurface) GetContent() (_ cairo.Content, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.GetContent()
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface Surface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface ImageSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface RecordingSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface SurfaceObserver) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface PDFSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface PSSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface SVGSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface XlibSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface ScriptSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.MIMEData.
+func (surface TeeSurface) MIMEData(mimeType string) (_ []byte, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.MIMEData(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface Surface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.Surface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface ImageSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface RecordingSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface SurfaceObserver) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface PDFSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface PSSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.PSSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface SVGSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface XlibSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface ScriptSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SetMIMEData.
+func (surface TeeSurface) SetMIMEData(mimeType string, data []byte) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.SetMIMEData(mimeType, data)
+	return nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface Surface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface ImageSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface RecordingSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface SurfaceObserver) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface PDFSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface PSSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface SVGSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface XlibSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface ScriptSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.SupportsMimeType.
+func (surface TeeSurface) SupportsMimeType(mimeType string) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.SupportsMimeType(mimeType)
+	return r0, nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface Surface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.Surface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface ImageSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface RecordingSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface SurfaceObserver) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface PDFSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface PSSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.PSSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface SVGSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface XlibSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface ScriptSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.GetFontOptions.
+func (surface TeeSurface) GetFontOptions(options *cairo.FontOptions) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.GetFontOptions(options)
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface Surface) Flush() (err error) {
+	defer catch(&err)
+	surface.Surface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface ImageSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.ImageSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface RecordingSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface SurfaceObserver) Flush() (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface PDFSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.PDFSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface PSSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.PSSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface SVGSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.SVGSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface XlibSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.XlibSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface ScriptSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.Flush.
+func (surface TeeSurface) Flush() (err error) {
+	defer catch(&err)
+	surface.TeeSurface.Flush()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface Surface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.Surface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface ImageSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.ImageSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface RecordingSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface SurfaceObserver) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface PDFSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.PDFSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface PSSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.PSSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface SVGSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.SVGSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface XlibSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.XlibSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface ScriptSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirty.
+func (surface TeeSurface) MarkDirty() (err error) {
+	defer catch(&err)
+	surface.TeeSurface.MarkDirty()
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface Surface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.Surface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface ImageSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface RecordingSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface SurfaceObserver) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface PDFSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface PSSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.PSSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface SVGSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface XlibSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface ScriptSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.MarkDirtyRectangle.
+func (surface TeeSurface) MarkDirtyRectangle(x, y, width, height int) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.MarkDirtyRectangle(x, y, width, height)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface Surface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.Surface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface ImageSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface RecordingSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface SurfaceObserver) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface PDFSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface PSSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.PSSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface SVGSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface XlibSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface ScriptSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceScale.
+func (surface TeeSurface) SetDeviceScale(xScale, yScale float64) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.SetDeviceScale(xScale, yScale)
+	return nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface Surface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.Surface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface ImageSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.ImageSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface RecordingSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.RecordingSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface SurfaceObserver) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.SurfaceObserver.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface PDFSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.PDFSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface PSSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.PSSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface SVGSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.SVGSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface XlibSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.XlibSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface ScriptSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.ScriptSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceScale.
+func (surface TeeSurface) GetDeviceScale() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.TeeSurface.GetDeviceScale()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface Surface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.Surface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface ImageSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface RecordingSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface SurfaceObserver) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface PDFSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface PSSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.PSSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface SVGSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface XlibSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface ScriptSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.SetDeviceOffset.
+func (surface TeeSurface) SetDeviceOffset(xOffset, yOffset float64) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.SetDeviceOffset(xOffset, yOffset)
+	return nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface Surface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.Surface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface ImageSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.ImageSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface RecordingSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.RecordingSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface SurfaceObserver) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.SurfaceObserver.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface PDFSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.PDFSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface PSSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.PSSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface SVGSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.SVGSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface XlibSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.XlibSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface ScriptSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.ScriptSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetDeviceOffset.
+func (surface TeeSurface) GetDeviceOffset() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.TeeSurface.GetDeviceOffset()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface Surface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.Surface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface ImageSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.ImageSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface RecordingSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface SurfaceObserver) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface PDFSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface PSSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.PSSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface SVGSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface XlibSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface ScriptSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.SetFallbackResolution.
+func (surface TeeSurface) SetFallbackResolution(xPixelsPerInch, yPixelsPerInch float64) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.SetFallbackResolution(xPixelsPerInch, yPixelsPerInch)
+	return nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface Surface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.Surface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface ImageSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.ImageSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface RecordingSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.RecordingSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface SurfaceObserver) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.SurfaceObserver.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface PDFSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.PDFSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface PSSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.PSSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface SVGSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.SVGSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface XlibSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.XlibSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface ScriptSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.ScriptSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.GetFallbackResolution.
+func (surface TeeSurface) GetFallbackResolution() (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1 := surface.TeeSurface.GetFallbackResolution()
+	return r0, r1, nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface Surface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.Surface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface ImageSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.ImageSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface RecordingSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface SurfaceObserver) CopyPage() (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface PDFSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.PDFSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface PSSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.PSSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface SVGSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.SVGSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface XlibSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.XlibSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface ScriptSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.CopyPage.
+func (surface TeeSurface) CopyPage() (err error) {
+	defer catch(&err)
+	surface.TeeSurface.CopyPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface Surface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.Surface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface ImageSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.ImageSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface RecordingSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface SurfaceObserver) ShowPage() (err error) {
+	defer catch(&err)
+	surface.SurfaceObserver.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface PDFSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.PDFSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface PSSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.PSSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface SVGSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.SVGSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface XlibSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.XlibSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface ScriptSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.ScriptSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.ShowPage.
+func (surface TeeSurface) ShowPage() (err error) {
+	defer catch(&err)
+	surface.TeeSurface.ShowPage()
+	return nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface Surface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.Surface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface ImageSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface RecordingSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface SurfaceObserver) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.SurfaceObserver.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface PDFSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.PDFSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface PSSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface SVGSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface XlibSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface ScriptSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.ScriptSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.Surface.HasShowTextGlyphs.
+func (surface TeeSurface) HasShowTextGlyphs() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.HasShowTextGlyphs()
+	return r0, nil
+}
+
+// See cairo.ImageSurfaceCreate.
+func ImageSurfaceCreate(format cairo.Format, width, height int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.ImageSurfaceCreate(format, width, height)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.ImageSurfaceCreateForData.
+func ImageSurfaceCreateForData(data unsafe.Pointer, format cairo.Format, width, height, stride int) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.ImageSurfaceCreateForData(data, format, width, height, stride)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.ImageSurface.Data.
+func (i ImageSurface) Data() (_ []byte, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.Data()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.DataView.
+func (i ImageSurface) DataView() (_ []byte, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.DataView()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.UpdateData.
+func (i ImageSurface) UpdateData(f func([]byte)) (err error) {
+	defer catch(&err)
+	i.ImageSurface.UpdateData(f)
+	return nil
+}
+
+// See cairo.ImageSurface.GetFormat.
+func (surface ImageSurface) GetFormat() (_ cairo.Format, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetFormat()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.GetWidth.
+func (surface ImageSurface) GetWidth() (_ int, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetWidth()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.GetHeight.
+func (surface ImageSurface) GetHeight() (_ int, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetHeight()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.GetStride.
+func (surface ImageSurface) GetStride() (_ int, err error) {
+	defer catch(&err)
+	r0 := surface.ImageSurface.GetStride()
+	return r0, nil
+}
+
+// See cairo.RecordingSurfaceCreate.
+func RecordingSurfaceCreate(content cairo.Content, extents *cairo.Rectangle) (_ RecordingSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.RecordingSurfaceCreate(content, extents)
+	return RecordingSurface{r0}, nil
+}
+
+// See cairo.RecordingSurface.InkExtents.
+func (surface RecordingSurface) InkExtents() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3 := surface.RecordingSurface.InkExtents()
+	return r0, r1, r2, r3, nil
+}
+
+// See cairo.RecordingSurface.GetExtents.
+func (surface RecordingSurface) GetExtents(extents *cairo.Rectangle) (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.RecordingSurface.GetExtents(extents)
+	return r0, nil
+}
+
+// See cairo.RecordingSurface.ReplayTo.
+func (surface RecordingSurface) ReplayTo(target *cairo.Surface, m *cairo.Matrix) (err error) {
+	defer catch(&err)
+	surface.RecordingSurface.ReplayTo(target, m)
+	return nil
+}
+
+// See cairo.RasterSourcePatternCreate.
+func RasterSourcePatternCreate(source cairo.RasterSource, content cairo.Content, width, height int) (_ RasterSourcePattern, err error) {
+	defer catch(&err)
+	r0 := cairo.RasterSourcePatternCreate(source, content, width, height)
+	return RasterSourcePattern{r0}, nil
+}
+
+// See cairo.PatternCreateRGB.
+func PatternCreateRGB(red, green, blue float64) (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0 := cairo.PatternCreateRGB(red, green, blue)
+	return SolidPattern{r0}, nil
+}
+
+// See cairo.PatternCreateRGBA.
+func PatternCreateRGBA(red, green, blue, alpha float64) (_ SolidPattern, err error) {
+	defer catch(&err)
+	r0 := cairo.PatternCreateRGBA(red, green, blue, alpha)
+	return SolidPattern{r0}, nil
+}
+
+// See cairo.PatternCreateForSurface.
+func PatternCreateForSurface(surface *cairo.Surface) (_ SurfacePattern, err error) {
+	defer catch(&err)
+	r0 := cairo.PatternCreateForSurface(surface)
+	return SurfacePattern{r0}, nil
+}
+
+// See cairo.PatternCreateLinear.
+func PatternCreateLinear(x0, y0, x1, y1 float64) (_ LinearGradient, err error) {
+	defer catch(&err)
+	r0 := cairo.PatternCreateLinear(x0, y0, x1, y1)
+	return LinearGradient{r0}, nil
+}
+
+// See cairo.PatternCreateRadial.
+func PatternCreateRadial(cx0, cy0, radius0, cx1, cy1, radius1 float64) (_ RadialGradient, err error) {
+	defer catch(&err)
+	r0 := cairo.PatternCreateRadial(cx0, cy0, radius0, cx1, cy1, radius1)
+	return RadialGradient{r0}, nil
+}
+
+// See cairo.PatternCreateMesh.
+func PatternCreateMesh() (_ MeshPattern, err error) {
+	defer catch(&err)
+	r0 := cairo.PatternCreateMesh()
+	return MeshPattern{r0}, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern Pattern) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.Pattern.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern MeshPattern) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.MeshPattern.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern RasterSourcePattern) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.RasterSourcePattern.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern LinearGradient) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.LinearGradient.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern RadialGradient) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.RadialGradient.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern SurfacePattern) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.SurfacePattern.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetType.
+func (pattern SolidPattern) GetType() (_ cairo.PatternType, err error) {
+	defer catch(&err)
+	r0 := pattern.SolidPattern.GetType()
+	return r0, nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern Pattern) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.Pattern.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern MeshPattern) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern RasterSourcePattern) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.RasterSourcePattern.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern LinearGradient) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.LinearGradient.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern RadialGradient) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.RadialGradient.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern SurfacePattern) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.SurfacePattern.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGB.
+func (pattern SolidPattern) AddColorStopRGB(offset, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.SolidPattern.AddColorStopRGB(offset, red, green, blue)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern Pattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.Pattern.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern MeshPattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern RasterSourcePattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.RasterSourcePattern.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern LinearGradient) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.LinearGradient.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern RadialGradient) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.RadialGradient.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern SurfacePattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.SurfacePattern.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.AddColorStopRGBA.
+func (pattern SolidPattern) AddColorStopRGBA(offset, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.SolidPattern.AddColorStopRGBA(offset, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.MeshPattern.BeginPatch.
+func (pattern MeshPattern) BeginPatch() (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.BeginPatch()
+	return nil
+}
+
+// See cairo.MeshPattern.EndPatch.
+func (pattern MeshPattern) EndPatch() (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.EndPatch()
+	return nil
+}
+
+// See cairo.MeshPattern.CurveTo.
+func (pattern MeshPattern) CurveTo(x1, y1, x2, y2, x3, y3 float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.CurveTo(x1, y1, x2, y2, x3, y3)
+	return nil
+}
+
+// See cairo.MeshPattern.LineTo.
+func (pattern MeshPattern) LineTo(x, y float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.LineTo(x, y)
+	return nil
+}
+
+// See cairo.MeshPattern.MoveTo.
+func (pattern MeshPattern) MoveTo(x, y float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.MoveTo(x, y)
+	return nil
+}
+
+// See cairo.MeshPattern.SetControlPoint.
+func (pattern MeshPattern) SetControlPoint(pointNum int, x, y float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.SetControlPoint(pointNum, x, y)
+	return nil
+}
+
+// See cairo.MeshPattern.SetCornerColorRGB.
+func (pattern MeshPattern) SetCornerColorRGB(cornerNum int, red, green, blue float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.SetCornerColorRGB(cornerNum, red, green, blue)
+	return nil
+}
+
+// See cairo.MeshPattern.SetCornerColorRGBA.
+func (pattern MeshPattern) SetCornerColorRGBA(cornerNum int, red, green, blue, alpha float64) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.SetCornerColorRGBA(cornerNum, red, green, blue, alpha)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern Pattern) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.Pattern.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern MeshPattern) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern RasterSourcePattern) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.RasterSourcePattern.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern LinearGradient) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.LinearGradient.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern RadialGradient) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.RadialGradient.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern SurfacePattern) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.SurfacePattern.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetMatrix.
+func (pattern SolidPattern) SetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.SolidPattern.SetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern Pattern) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.Pattern.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern MeshPattern) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern RasterSourcePattern) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.RasterSourcePattern.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern LinearGradient) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.LinearGradient.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern RadialGradient) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.RadialGradient.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern SurfacePattern) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.SurfacePattern.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.GetMatrix.
+func (pattern SolidPattern) GetMatrix(matrix *cairo.Matrix) (err error) {
+	defer catch(&err)
+	pattern.SolidPattern.GetMatrix(matrix)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern Pattern) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.Pattern.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern MeshPattern) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern RasterSourcePattern) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.RasterSourcePattern.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern LinearGradient) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.LinearGradient.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern RadialGradient) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.RadialGradient.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern SurfacePattern) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.SurfacePattern.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.SetExtend.
+func (pattern SolidPattern) SetExtend(extend cairo.Extend) (err error) {
+	defer catch(&err)
+	pattern.SolidPattern.SetExtend(extend)
+	return nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern Pattern) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.Pattern.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern MeshPattern) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.MeshPattern.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern RasterSourcePattern) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.RasterSourcePattern.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern LinearGradient) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.LinearGradient.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern RadialGradient) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.RadialGradient.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern SurfacePattern) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.SurfacePattern.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetExtend.
+func (pattern SolidPattern) GetExtend() (_ cairo.Extend, err error) {
+	defer catch(&err)
+	r0 := pattern.SolidPattern.GetExtend()
+	return r0, nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern Pattern) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.Pattern.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern MeshPattern) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.MeshPattern.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern RasterSourcePattern) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.RasterSourcePattern.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern LinearGradient) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.LinearGradient.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern RadialGradient) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.RadialGradient.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern SurfacePattern) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.SurfacePattern.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.SetFilter.
+func (pattern SolidPattern) SetFilter(filter cairo.Filter) (err error) {
+	defer catch(&err)
+	pattern.SolidPattern.SetFilter(filter)
+	return nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern Pattern) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.Pattern.GetFilter()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern MeshPattern) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.MeshPattern.GetFilter()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern RasterSourcePattern) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.RasterSourcePattern.GetFilter()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern LinearGradient) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.LinearGradient.GetFilter()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern RadialGradient) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.RadialGradient.GetFilter()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern SurfacePattern) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.SurfacePattern.GetFilter()
+	return r0, nil
+}
+
+// See cairo.Pattern.GetFilter.
+func (pattern SolidPattern) GetFilter() (_ cairo.Filter, err error) {
+	defer catch(&err)
+	r0 := pattern.SolidPattern.GetFilter()
+	return r0, nil
+}
+
+// See cairo.SolidPattern.GetRGBA.
+func (pattern SolidPattern) GetRGBA() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3, e := pattern.SolidPattern.GetRGBA()
+	return r0, r1, r2, r3, e
+}
+
+// See cairo.SurfacePattern.Surface.
+func (pattern SurfacePattern) Surface() (_ Surface, err error) {
+	defer catch(&err)
+	r0, e := pattern.SurfacePattern.Surface()
+	return Surface{r0}, e
+}
+
+// See cairo.LinearGradient.GetColorStopRGBA.
+func (pattern LinearGradient) GetColorStopRGBA(index int) (_, _, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3, r4, e := pattern.LinearGradient.GetColorStopRGBA(index)
+	return r0, r1, r2, r3, r4, e
+}
+
+// See cairo.RadialGradient.GetColorStopRGBA.
+func (pattern RadialGradient) GetColorStopRGBA(index int) (_, _, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3, r4, e := pattern.RadialGradient.GetColorStopRGBA(index)
+	return r0, r1, r2, r3, r4, e
+}
+
+// See cairo.LinearGradient.GetColorStopCount.
+func (pattern LinearGradient) GetColorStopCount() (_ int, err error) {
+	defer catch(&err)
+	r0, e := pattern.LinearGradient.GetColorStopCount()
+	return r0, e
+}
+
+// See cairo.RadialGradient.GetColorStopCount.
+func (pattern RadialGradient) GetColorStopCount() (_ int, err error) {
+	defer catch(&err)
+	r0, e := pattern.RadialGradient.GetColorStopCount()
+	return r0, e
+}
+
+// See cairo.LinearGradient.GetLinearPoints.
+func (pattern LinearGradient) GetLinearPoints() (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3, e := pattern.LinearGradient.GetLinearPoints()
+	return r0, r1, r2, r3, e
+}
+
+// See cairo.RadialGradient.GetRadialCircles.
+func (pattern RadialGradient) GetRadialCircles() (_, _, _, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3, r4, r5, e := pattern.RadialGradient.GetRadialCircles()
+	return r0, r1, r2, r3, r4, r5, e
+}
+
+// See cairo.MeshPattern.GetPatchCount.
+func (pattern MeshPattern) GetPatchCount() (_ int, err error) {
+	defer catch(&err)
+	r0, e := pattern.MeshPattern.GetPatchCount()
+	return r0, e
+}
+
+// See cairo.MeshPattern.GetPath.
+func (pattern MeshPattern) GetPath(patchNum int) (_ Path, err error) {
+	defer catch(&err)
+	r0 := pattern.MeshPattern.GetPath(patchNum)
+	return Path{r0}, nil
+}
+
+// See cairo.MeshPattern.GetCornerColorRGBA.
+func (pattern MeshPattern) GetCornerColorRGBA(patchNum, cornerNum int) (_, _, _, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, r2, r3, e := pattern.MeshPattern.GetCornerColorRGBA(patchNum, cornerNum)
+	return r0, r1, r2, r3, e
+}
+
+// See cairo.MeshPattern.GetControlPoint.
+func (pattern MeshPattern) GetControlPoint(patchNum, pointNum int) (_, _ float64, err error) {
+	defer catch(&err)
+	r0, r1, e := pattern.MeshPattern.GetControlPoint(patchNum, pointNum)
+	return r0, r1, e
+}
+
+// See cairo.RegionCreate.
+func RegionCreate() (_ Region, err error) {
+	defer catch(&err)
+	r0 := cairo.RegionCreate()
+	return Region{r0}, nil
+}
+
+// See cairo.RegionCreateRectangle.
+func RegionCreateRectangle(rectangle *cairo.RectangleInt) (_ Region, err error) {
+	defer catch(&err)
+	r0 := cairo.RegionCreateRectangle(rectangle)
+	return Region{r0}, nil
+}
+
+// See cairo.RegionCreateRectangles.
+func RegionCreateRectangles(rects []cairo.RectangleInt) (_ Region, err error) {
+	defer catch(&err)
+	r0 := cairo.RegionCreateRectangles(rects)
+	return Region{r0}, nil
+}
+
+// See cairo.Region.Copy.
+func (original Region) Copy() (_ Region, err error) {
+	defer catch(&err)
+	r0 := original.Region.Copy()
+	return Region{r0}, nil
+}
+
+// See cairo.Region.Equal.
+func (a Region) Equal(b *cairo.Region) (_ bool, err error) {
+	defer catch(&err)
+	r0 := a.Region.Equal(b)
+	return r0, nil
+}
+
+// See cairo.Region.GetExtents.
+func (region Region) GetExtents() (_ cairo.RectangleInt, err error) {
+	defer catch(&err)
+	r0 := region.Region.GetExtents()
+	return r0, nil
+}
+
+// See cairo.Region.NumRectangles.
+func (region Region) NumRectangles() (_ int, err error) {
+	defer catch(&err)
+	r0 := region.Region.NumRectangles()
+	return r0, nil
+}
+
+// See cairo.Region.GetRectangle.
+func (region Region) GetRectangle(nth int) (_ cairo.RectangleInt, err error) {
+	defer catch(&err)
+	r0 := region.Region.GetRectangle(nth)
+	return r0, nil
+}
+
+// See cairo.Region.IsEmpty.
+func (region Region) IsEmpty() (_ bool, err error) {
+	defer catch(&err)
+	r0 := region.Region.IsEmpty()
+	return r0, nil
+}
+
+// See cairo.Region.ContainsRectangle.
+func (region Region) ContainsRectangle(rectangle *cairo.RectangleInt) (_ cairo.RegionOverlap, err error) {
+	defer catch(&err)
+	r0 := region.Region.ContainsRectangle(rectangle)
+	return r0, nil
+}
+
+// See cairo.Region.ContainsPoint.
+func (region Region) ContainsPoint(x, y int) (_ bool, err error) {
+	defer catch(&err)
+	r0 := region.Region.ContainsPoint(x, y)
+	return r0, nil
+}
+
+// See cairo.Region.Translate.
+func (region Region) Translate(dx, dy int) (err error) {
+	defer catch(&err)
+	region.Region.Translate(dx, dy)
+	return nil
+}
+
+// See cairo.Region.Subtract.
+func (dst Region) Subtract(other *cairo.Region) (err error) {
+	defer catch(&err)
+	return dst.Region.Subtract(other)
+}
+
+// See cairo.Region.SubtractRectangle.
+func (dst Region) SubtractRectangle(rectangle *cairo.RectangleInt) (err error) {
+	defer catch(&err)
+	return dst.Region.SubtractRectangle(rectangle)
+}
+
+// See cairo.Region.Intersect.
+func (dst Region) Intersect(other *cairo.Region) (err error) {
+	defer catch(&err)
+	return dst.Region.Intersect(other)
+}
+
+// See cairo.Region.IntersectRectangle.
+func (dst Region) IntersectRectangle(rectangle *cairo.RectangleInt) (err error) {
+	defer catch(&err)
+	return dst.Region.IntersectRectangle(rectangle)
+}
+
+// See cairo.Region.Union.
+func (dst Region) Union(other *cairo.Region) (err error) {
+	defer catch(&err)
+	return dst.Region.Union(other)
+}
+
+// See cairo.Region.UnionRectangle.
+func (dst Region) UnionRectangle(rectangle *cairo.RectangleInt) (err error) {
+	defer catch(&err)
+	return dst.Region.UnionRectangle(rectangle)
+}
+
+// See cairo.Region.XOR.
+func (dst Region) XOR(other *cairo.Region) (err error) {
+	defer catch(&err)
+	return dst.Region.XOR(other)
+}
+
+// See cairo.Region.XORRectangle.
+func (dst Region) XORRectangle(rectangle *cairo.RectangleInt) (err error) {
+	defer catch(&err)
+	return dst.Region.XORRectangle(rectangle)
+}
+
+// See cairo.FTFontFaceCreateForFTFace.
+func FTFontFaceCreateForFTFace(face unsafe.Pointer, loadFlags int) (_ FTFontFace, err error) {
+	defer catch(&err)
+	r0 := cairo.FTFontFaceCreateForFTFace(face, loadFlags)
+	return FTFontFace{r0}, nil
+}
+
+// See cairo.FTFontFace.SetSynthesize.
+func (fontFace FTFontFace) SetSynthesize(synthFlags cairo.FTSynthesize) (err error) {
+	defer catch(&err)
+	fontFace.FTFontFace.SetSynthesize(synthFlags)
+	return nil
+}
+
+// See cairo.FTFontFace.UnsetSynthesize.
+func (fontFace FTFontFace) UnsetSynthesize(synthFlags cairo.FTSynthesize) (err error) {
+	defer catch(&err)
+	fontFace.FTFontFace.UnsetSynthesize(synthFlags)
+	return nil
+}
+
+// See cairo.FTFontFace.GetSynthesize.
+func (fontFace FTFontFace) GetSynthesize() (_ cairo.FTSynthesize, err error) {
+	defer catch(&err)
+	r0 := fontFace.FTFontFace.GetSynthesize()
+	return r0, nil
+}
+
+// See cairo.FTScaledFont.LockFace.
+func (scaledFont FTScaledFont) LockFace() (_ unsafe.Pointer, err error) {
+	defer catch(&err)
+	r0 := scaledFont.FTScaledFont.LockFace()
+	return r0, nil
+}
+
+// See cairo.FTScaledFont.UnlockFace.
+func (scaledFont FTScaledFont) UnlockFace() (err error) {
+	defer catch(&err)
+	scaledFont.FTScaledFont.UnlockFace()
+	return nil
+}
+
+// See cairo.FTFontFaceCreateForPattern.
+func FTFontFaceCreateForPattern(pattern *cairo.FcPattern) (_ FTFontFace, err error) {
+	defer catch(&err)
+	r0 := cairo.FTFontFaceCreateForPattern(pattern)
+	return FTFontFace{r0}, nil
+}
+
+// See cairo.FTFontOptionsSubstitute.
+func FTFontOptionsSubstitute(options *cairo.FontOptions, pattern *cairo.FcPattern) (err error) {
+	defer catch(&err)
+	cairo.FTFontOptionsSubstitute(options, pattern)
+	return nil
+}
+
+// See cairo.PDFSurfaceCreate.
+func PDFSurfaceCreate(filename string, widthInPoints, heightInPoints float64) (_ PDFSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.PDFSurfaceCreate(filename, widthInPoints, heightInPoints)
+	return PDFSurface{r0}, nil
+}
+
+// See cairo.PDFSurfaceCreateForStream.
+func PDFSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) (_ PDFSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.PDFSurfaceCreateForStream(w, widthInPoints, heightInPoints)
+	return PDFSurface{r0}, nil
+}
+
+// See cairo.PDFSurface.RestrictToVersion.
+func (surface PDFSurface) RestrictToVersion(version cairo.PDFVersion) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.RestrictToVersion(version)
+	return nil
+}
+
+// See cairo.PDFSurface.SetSize.
+func (surface PDFSurface) SetSize(widthInPoints, heightInPoints float64) (err error) {
+	defer catch(&err)
+	surface.PDFSurface.SetSize(widthInPoints, heightInPoints)
+	return nil
+}
+
+// See cairo.PSSurfaceCreate.
+func PSSurfaceCreate(filename string, widthInPoints, heightInPoints float64) (_ PSSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.PSSurfaceCreate(filename, widthInPoints, heightInPoints)
+	return PSSurface{r0}, nil
+}
+
+// See cairo.PSSurfaceCreateForStream.
+func PSSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) (_ PSSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.PSSurfaceCreateForStream(w, widthInPoints, heightInPoints)
+	return PSSurface{r0}, nil
+}
+
+// See cairo.PSSurface.RestrictToLevel.
+func (surface PSSurface) RestrictToLevel(level cairo.PSLevel) (err error) {
+	defer catch(&err)
+	surface.PSSurface.RestrictToLevel(level)
+	return nil
+}
+
+// See cairo.PSSurface.SetEPS.
+func (surface PSSurface) SetEPS(eps bool) (err error) {
+	defer catch(&err)
+	surface.PSSurface.SetEPS(eps)
+	return nil
+}
+
+// See cairo.PSSurface.GetEPS.
+func (surface PSSurface) GetEPS() (_ bool, err error) {
+	defer catch(&err)
+	r0 := surface.PSSurface.GetEPS()
+	return r0, nil
+}
+
+// See cairo.PSSurface.SetSize.
+func (surface PSSurface) SetSize(widthInPoints, heightInPoints float64) (err error) {
+	defer catch(&err)
+	surface.PSSurface.SetSize(widthInPoints, heightInPoints)
+	return nil
+}
+
+// See cairo.PSSurface.DSCComment.
+func (surface PSSurface) DSCComment(comment string) (err error) {
+	defer catch(&err)
+	surface.PSSurface.DSCComment(comment)
+	return nil
+}
+
+// See cairo.PSSurface.DSCBeginSetup.
+func (surface PSSurface) DSCBeginSetup() (err error) {
+	defer catch(&err)
+	surface.PSSurface.DSCBeginSetup()
+	return nil
+}
+
+// See cairo.PSSurface.DSCBeginPageSetup.
+func (surface PSSurface) DSCBeginPageSetup() (err error) {
+	defer catch(&err)
+	surface.PSSurface.DSCBeginPageSetup()
+	return nil
+}
+
+// See cairo.SVGSurfaceCreate.
+func SVGSurfaceCreate(filename string, widthInPoints, heightInPoints float64) (_ SVGSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.SVGSurfaceCreate(filename, widthInPoints, heightInPoints)
+	return SVGSurface{r0}, nil
+}
+
+// See cairo.SVGSurfaceCreateForStream.
+func SVGSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) (_ SVGSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.SVGSurfaceCreateForStream(w, widthInPoints, heightInPoints)
+	return SVGSurface{r0}, nil
+}
+
+// See cairo.SVGSurface.RestrictToVersion.
+func (surface SVGSurface) RestrictToVersion(version cairo.SVGVersion) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.RestrictToVersion(version)
+	return nil
+}
+
+// See cairo.SVGSurface.SetDocumentUnit.
+func (surface SVGSurface) SetDocumentUnit(unit cairo.SVGUnit) (err error) {
+	defer catch(&err)
+	surface.SVGSurface.SetDocumentUnit(unit)
+	return nil
+}
+
+// See cairo.SVGSurface.GetDocumentUnit.
+func (surface SVGSurface) GetDocumentUnit() (_ cairo.SVGUnit, err error) {
+	defer catch(&err)
+	r0 := surface.SVGSurface.GetDocumentUnit()
+	return r0, nil
+}
+
+// See cairo.XlibSurfaceCreate.
+func XlibSurfaceCreate(dpy unsafe.Pointer, drawable uint64, visual unsafe.Pointer, width, height int) (_ XlibSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.XlibSurfaceCreate(dpy, drawable, visual, width, height)
+	return XlibSurface{r0}, nil
+}
+
+// See cairo.XlibSurfaceCreateForBitmap.
+func XlibSurfaceCreateForBitmap(dpy unsafe.Pointer, bitmap uint64, screen unsafe.Pointer, width, height int) (_ XlibSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.XlibSurfaceCreateForBitmap(dpy, bitmap, screen, width, height)
+	return XlibSurface{r0}, nil
+}
+
+// See cairo.XlibSurface.SetSize.
+func (surface XlibSurface) SetSize(width, height int) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.SetSize(width, height)
+	return nil
+}
+
+// See cairo.XlibSurface.SetDrawable.
+func (surface XlibSurface) SetDrawable(drawable uint64, width, height int) (err error) {
+	defer catch(&err)
+	surface.XlibSurface.SetDrawable(drawable, width, height)
+	return nil
+}
+
+// See cairo.XlibSurface.GetDisplay.
+func (surface XlibSurface) GetDisplay() (_ unsafe.Pointer, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetDisplay()
+	return r0, nil
+}
+
+// See cairo.XlibSurface.GetDrawable.
+func (surface XlibSurface) GetDrawable() (_ uint64, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetDrawable()
+	return r0, nil
+}
+
+// See cairo.XlibSurface.GetScreen.
+func (surface XlibSurface) GetScreen() (_ unsafe.Pointer, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetScreen()
+	return r0, nil
+}
+
+// See cairo.XlibSurface.GetVisual.
+func (surface XlibSurface) GetVisual() (_ unsafe.Pointer, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetVisual()
+	return r0, nil
+}
+
+// See cairo.XlibSurface.GetDepth.
+func (surface XlibSurface) GetDepth() (_ int, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetDepth()
+	return r0, nil
+}
+
+// See cairo.XlibSurface.GetWidth.
+func (surface XlibSurface) GetWidth() (_ int, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetWidth()
+	return r0, nil
+}
+
+// See cairo.XlibSurface.GetHeight.
+func (surface XlibSurface) GetHeight() (_ int, err error) {
+	defer catch(&err)
+	r0 := surface.XlibSurface.GetHeight()
+	return r0, nil
+}
+
+// See cairo.XlibDevice.DebugCapXrenderVersion.
+func (device XlibDevice) DebugCapXrenderVersion(majorVersion, minorVersion int) (err error) {
+	defer catch(&err)
+	device.XlibDevice.DebugCapXrenderVersion(majorVersion, minorVersion)
+	return nil
+}
+
+// See cairo.XlibDevice.DebugSetPrecision.
+func (device XlibDevice) DebugSetPrecision(precision int) (err error) {
+	defer catch(&err)
+	device.XlibDevice.DebugSetPrecision(precision)
+	return nil
+}
+
+// See cairo.XlibDevice.DebugGetPrecision.
+func (device XlibDevice) DebugGetPrecision() (_ int, err error) {
+	defer catch(&err)
+	r0 := device.XlibDevice.DebugGetPrecision()
+	return r0, nil
+}
+
+// See cairo.ScriptCreate.
+func ScriptCreate(filename string) (_ ScriptDevice, err error) {
+	defer catch(&err)
+	r0 := cairo.ScriptCreate(filename)
+	return ScriptDevice{r0}, nil
+}
+
+// See cairo.ScriptCreateForStream.
+func ScriptCreateForStream(w io.Writer) (_ ScriptDevice, err error) {
+	defer catch(&err)
+	r0 := cairo.ScriptCreateForStream(w)
+	return ScriptDevice{r0}, nil
+}
+
+// See cairo.ScriptDevice.WriteComment.
+func (script ScriptDevice) WriteComment(comment string) (err error) {
+	defer catch(&err)
+	script.ScriptDevice.WriteComment(comment)
+	return nil
+}
+
+// See cairo.ScriptDevice.SetMode.
+func (script ScriptDevice) SetMode(mode cairo.ScriptMode) (err error) {
+	defer catch(&err)
+	script.ScriptDevice.SetMode(mode)
+	return nil
+}
+
+// See cairo.ScriptDevice.GetMode.
+func (script ScriptDevice) GetMode() (_ cairo.ScriptMode, err error) {
+	defer catch(&err)
+	r0 := script.ScriptDevice.GetMode()
+	return r0, nil
+}
+
+// See cairo.ScriptSurfaceCreate.
+func ScriptSurfaceCreate(script *cairo.Device, content cairo.Content, width, height float64) (_ ScriptSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.ScriptSurfaceCreate(script, content, width, height)
+	return ScriptSurface{r0}, nil
+}
+
+// See cairo.ScriptSurfaceCreateForTarget.
+func ScriptSurfaceCreateForTarget(script *cairo.Device, target *cairo.Surface) (_ ScriptSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.ScriptSurfaceCreateForTarget(script, target)
+	return ScriptSurface{r0}, nil
+}
+
+// See cairo.ScriptDevice.FromRecordingSurface.
+func (script ScriptDevice) FromRecordingSurface(recordingSurface *cairo.Surface) (err error) {
+	defer catch(&err)
+	return script.ScriptDevice.FromRecordingSurface(recordingSurface)
+}
+
+// See cairo.TeeSurfaceCreate.
+func TeeSurfaceCreate(primary *cairo.Surface) (_ TeeSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.TeeSurfaceCreate(primary)
+	return TeeSurface{r0}, nil
+}
+
+// See cairo.TeeSurface.Add.
+func (surface TeeSurface) Add(target *cairo.Surface) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.Add(target)
+	return nil
+}
+
+// See cairo.TeeSurface.Remove.
+func (surface TeeSurface) Remove(target *cairo.Surface) (err error) {
+	defer catch(&err)
+	surface.TeeSurface.Remove(target)
+	return nil
+}
+
+// See cairo.TeeSurface.Index.
+func (surface TeeSurface) Index(index int) (_ Surface, err error) {
+	defer catch(&err)
+	r0 := surface.TeeSurface.Index(index)
+	return Surface{r0}, nil
+}
+
+// See cairo.ImageSurface.ColorModel.
+func (i ImageSurface) ColorModel() (_ color.Model, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.ColorModel()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.Bounds.
+func (i ImageSurface) Bounds() (_ image.Rectangle, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.Bounds()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.At.
+func (i ImageSurface) At(x, y int) (_ color.Color, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.At(x, y)
+	return r0, nil
+}
+
+// See cairo.ImageSurface.Set.
+func (i ImageSurface) Set(x, y int, c color.Color) (err error) {
+	defer catch(&err)
+	i.ImageSurface.Set(x, y, c)
+	return nil
+}
+
+// See cairo.ImageSurfaceFromImage.
+func ImageSurfaceFromImage(img image.Image) (_ ImageSurface, err error) {
+	defer catch(&err)
+	r0 := cairo.ImageSurfaceFromImage(img)
+	return ImageSurface{r0}, nil
+}
+
+// See cairo.ImageSurface.ToRGBA.
+func (i ImageSurface) ToRGBA() (_ *image.RGBA, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.ToRGBA()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.ToNRGBA.
+func (i ImageSurface) ToNRGBA() (_ *image.NRGBA, err error) {
+	defer catch(&err)
+	r0 := i.ImageSurface.ToNRGBA()
+	return r0, nil
+}
+
+// See cairo.ImageSurface.Encode.
+func (i ImageSurface) Encode(w io.Writer, format string, opts *cairo.EncodeOptions) (err error) {
+	defer catch(&err)
+	return i.ImageSurface.Encode(w, format, opts)
+}
@@ -0,0 +1,46 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package checked is a variant of the cairo package that returns errors
+instead of panicking.
+
+The cairo package panics with a cairo.Status whenever a call leaves an
+object in an error state.  That's convenient when errors can only be
+programmer errors, but a server drawing on behalf of its users would
+rather have them as values.  Each function and method in cairo that can
+panic has a counterpart here that calls it and returns the Status as
+an error.  This package is generated from the cairo package, so it
+covers the same API, hand-written parts included.  That means
+ImageSurface's At and Set return errors here too, so a checked
+ImageSurface isn't a draw.Image; pass its embedded *cairo.ImageSurface
+to the image packages instead.
+
+The types here embed their cairo counterparts, so fields and any
+methods that can't fail are still available, and values can be passed
+along to functions that expect the cairo types:
+
+	surf, err := checked.ImageSurfaceCreate(cairo.FormatARGB32, 640, 480)
+	if err != nil {
+		return err
+	}
+	cr, err := checked.Create(surf.Surface)
+	if err != nil {
+		return err
+	}
+	if err := cr.Paint(); err != nil {
+		return err
+	}
+*/
+package checked
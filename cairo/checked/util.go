@@ -0,0 +1,32 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checked
+
+import "github.com/martine/gocairo/cairo"
+
+// catch is deferred by every function in this package.  It recovers a
+// panic with a cairo.Status and stores it in *err; any other panic is
+// passed along.
+func catch(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	status, ok := r.(cairo.Status)
+	if !ok {
+		panic(r)
+	}
+	*err = status
+}
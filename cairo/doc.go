@@ -46,5 +46,19 @@ also implements Error so it can be stringified.
 
 (There's a few places that still accidentally return an error, but
 those will be fixed.)
+
+If you'd rather handle errors as values, for example because the
+drawing is driven by user input, use the cairo/checked package.  It
+has the same API but returns a Status as an error instead of
+panicking with it.
+
+Memory Management
+
+Cairo objects are reference counted in C, and the Go wrappers drop
+their reference from a finalizer once they're garbage collected.  The
+Go GC doesn't see the memory held on the C side, though, so programs
+that churn through large objects like big ImageSurfaces should call
+Close to release them right away.  Calling a method on an object after
+closing it panics with StatusNullPointer.
 */
 package cairo
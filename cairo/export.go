@@ -0,0 +1,82 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+// vectorExportFormats maps the vector formats Export supports to their
+// stream surfaces.  The backends this cairo was built with add
+// themselves.
+var vectorExportFormats = map[string]func(w io.Writer, widthInPoints, heightInPoints float64) *Surface{}
+
+// ExportTarget is a file for RecordingSurface.Export to write.
+type ExportTarget struct {
+	W io.Writer
+	// Format is "png", "pdf", "svg" or "ps".
+	Format string
+	// Scale multiplies the page size, e.g. to write PNGs at several
+	// resolutions.  Zero means 1.
+	Scale float64
+}
+
+// Export renders the recording to each of targets.  The page covers the
+// recording's InkExtents, in pixels for PNG and points for the vector
+// formats.  Export stops at the first error.
+func (surface *RecordingSurface) Export(targets ...ExportTarget) (err error) {
+	defer catchStatus(&err)
+	x0, y0, width, height := surface.InkExtents()
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("cairo: exporting an empty recording")
+	}
+	for _, t := range targets {
+		if err := surface.export(t, x0, y0, width, height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (surface *RecordingSurface) export(t ExportTarget, x0, y0, width, height float64) error {
+	scale := t.Scale
+	if scale == 0 {
+		scale = 1
+	}
+	var m Matrix
+	m.InitScale(scale, scale)
+	m.Translate(-x0, -y0)
+	width, height = width*scale, height*scale
+
+	if t.Format == "png" {
+		img := ImageSurfaceCreate(FormatARGB32, int(math.Ceil(width)), int(math.Ceil(height)))
+		defer img.Close()
+		surface.ReplayTo(img.Surface, &m)
+		return img.WriteToPNG(t.W)
+	}
+
+	create, ok := vectorExportFormats[t.Format]
+	if !ok {
+		return fmt.Errorf("cairo: unknown export format %q", t.Format)
+	}
+	target := create(t.W, width, height)
+	defer target.Close()
+	surface.ReplayTo(target, &m)
+	// Finishing writes out the rest of the file.
+	target.Finish()
+	return nil
+}
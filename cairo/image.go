@@ -0,0 +1,429 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"unsafe"
+)
+
+// ImageSurface implements draw.Image, so it works with the standard
+// image packages.  At and Set go through cgo a few times for every
+// pixel; for bulk access use DataView, ToRGBA or ImageSurfaceFromImage.
+var _ draw.Image = (*ImageSurface)(nil)
+
+// nativeEndian is the byte order of pixels in formats wider than a
+// byte, which cairo stores as native integers.
+var nativeEndian binary.ByteOrder = binary.LittleEndian
+
+func init() {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 0 {
+		nativeEndian = binary.BigEndian
+	}
+}
+
+// a1Bit returns the offset of the 32-bit word holding pixel x of a
+// FormatA1 row, and the mask of the pixel's bit within it.  The first
+// pixel is the least significant bit on little-endian machines and the
+// most significant one on big-endian machines.
+func a1Bit(x int) (int, uint32) {
+	bit := uint(x % 32)
+	if nativeEndian == binary.BigEndian {
+		bit = 31 - bit
+	}
+	return x / 32 * 4, 1 << bit
+}
+
+// a1At reports whether pixel x of a FormatA1 row is set.
+func a1At(row []byte, x int) bool {
+	ofs, mask := a1Bit(x)
+	return nativeEndian.Uint32(row[ofs:])&mask != 0
+}
+
+// pixelFormat returns the format of the surface, panicking with
+// StatusInvalidFormat if it's not one that At and Set understand.
+func (i *ImageSurface) pixelFormat() Format {
+	format := i.GetFormat()
+	switch format {
+	case FormatARGB32, FormatRGB24, FormatA8, FormatA1, FormatRGB16565, FormatRGB30:
+		return format
+	}
+	panic(StatusInvalidFormat)
+}
+
+// pixels is the layout and data of an image surface, fetched once so
+// that per-pixel code doesn't go through cgo for each pixel.
+type pixels struct {
+	format        Format
+	width, height int
+	stride        int
+	// data is nil if the surface has no data.
+	data []byte
+}
+
+// pixels returns the current layout and data of the surface.
+func (i *ImageSurface) pixels() pixels {
+	return pixels{
+		format: i.pixelFormat(),
+		width:  i.GetWidth(),
+		height: i.GetHeight(),
+		stride: i.GetStride(),
+		data:   i.DataView(),
+	}
+}
+
+// in reports whether (x, y) is a pixel of p that can be accessed.
+func (p *pixels) in(x, y int) bool {
+	return p.data != nil && 0 <= x && x < p.width && 0 <= y && y < p.height
+}
+
+// at returns the color of pixel (x, y), which must be in p.
+func (p *pixels) at(x, y int) color.Color {
+	row := p.data[y*p.stride:]
+	switch p.format {
+	case FormatARGB32:
+		v := nativeEndian.Uint32(row[4*x:])
+		return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), uint8(v >> 24)}
+	case FormatRGB24:
+		v := nativeEndian.Uint32(row[4*x:])
+		return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 0xff}
+	case FormatA8:
+		return color.Alpha{row[x]}
+	case FormatA1:
+		if a1At(row, x) {
+			return color.Alpha{0xff}
+		}
+		return color.Alpha{}
+	case FormatRGB16565:
+		v := nativeEndian.Uint16(row[2*x:])
+		r, g, b := uint8(v>>11), uint8(v>>5&0x3f), uint8(v&0x1f)
+		return color.RGBA{r<<3 | r>>2, g<<2 | g>>4, b<<3 | b>>2, 0xff}
+	default: // FormatRGB30
+		v := nativeEndian.Uint32(row[4*x:])
+		r, g, b := uint16(v>>20&0x3ff), uint16(v>>10&0x3ff), uint16(v&0x3ff)
+		return color.RGBA64{r<<6 | r>>4, g<<6 | g>>4, b<<6 | b>>4, 0xffff}
+	}
+}
+
+// set stores c at pixel (x, y), which must be in p.
+func (p *pixels) set(x, y int, c color.Color) {
+	row := p.data[y*p.stride:]
+	switch p.format {
+	case FormatARGB32, FormatRGB24:
+		c := color.RGBAModel.Convert(c).(color.RGBA)
+		if p.format == FormatRGB24 {
+			c.A = 0xff
+		}
+		nativeEndian.PutUint32(row[4*x:], uint32(c.A)<<24|uint32(c.R)<<16|uint32(c.G)<<8|uint32(c.B))
+	case FormatA8:
+		row[x] = color.AlphaModel.Convert(c).(color.Alpha).A
+	case FormatA1:
+		ofs, mask := a1Bit(x)
+		v := nativeEndian.Uint32(row[ofs:])
+		if color.AlphaModel.Convert(c).(color.Alpha).A >= 0x80 {
+			v |= mask
+		} else {
+			v &^= mask
+		}
+		nativeEndian.PutUint32(row[ofs:], v)
+	case FormatRGB16565:
+		c := color.RGBAModel.Convert(c).(color.RGBA)
+		nativeEndian.PutUint16(row[2*x:], uint16(c.R>>3)<<11|uint16(c.G>>2)<<5|uint16(c.B>>3))
+	default: // FormatRGB30
+		c := color.RGBA64Model.Convert(c).(color.RGBA64)
+		nativeEndian.PutUint32(row[4*x:], uint32(c.R>>6)<<20|uint32(c.G>>6)<<10|uint32(c.B>>6))
+	}
+}
+
+// ColorModel implements image.Image.  Colors in FormatARGB32 surfaces are
+// premultiplied like color.RGBA, so no conversion is needed.
+func (i *ImageSurface) ColorModel() color.Model {
+	return colorModel(i.pixelFormat())
+}
+
+func colorModel(format Format) color.Model {
+	switch format {
+	case FormatA8, FormatA1:
+		return color.AlphaModel
+	case FormatRGB30:
+		return color.RGBA64Model
+	default:
+		return color.RGBAModel
+	}
+}
+
+// Bounds implements image.Image.
+func (i *ImageSurface) Bounds() image.Rectangle {
+	return image.Rect(0, 0, i.GetWidth(), i.GetHeight())
+}
+
+// At implements image.Image.
+func (i *ImageSurface) At(x, y int) color.Color {
+	p := i.pixels()
+	if !p.in(x, y) {
+		return colorModel(p.format).Convert(color.Transparent)
+	}
+	return p.at(x, y)
+}
+
+// Set implements draw.Image.  Formats without alpha store the color as
+// if drawn over black.  Like writes to DataView, Set doesn't tell cairo
+// about the change: call MarkDirty once done setting pixels, before
+// drawing on the surface with cairo again, or do the Sets inside
+// UpdateData.
+func (i *ImageSurface) Set(x, y int, c color.Color) {
+	p := i.pixels()
+	if p.in(x, y) {
+		p.set(x, y, c)
+	}
+}
+
+// ImageSurfaceFromImage copies img into a new ImageSurface, with
+// img.Bounds().Min at (0, 0).  Gray images become FormatRGB24 and everything
+// else FormatARGB32.  *image.RGBA, *image.NRGBA and *image.Gray are
+// converted directly, other images pixel by pixel.
+func ImageSurfaceFromImage(img image.Image) *ImageSurface {
+	b := img.Bounds()
+	format := FormatARGB32
+	if _, ok := img.(*image.Gray); ok {
+		format = FormatRGB24
+	}
+	surface := ImageSurfaceCreate(format, b.Dx(), b.Dy())
+	surface.UpdateData(func(data []byte) {
+		stride := surface.GetStride()
+		switch src := img.(type) {
+		case *image.RGBA:
+			for y := 0; y < b.Dy(); y++ {
+				row := data[y*stride:]
+				in := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+				for x := 0; x < b.Dx(); x++ {
+					p := in[4*x : 4*x+4]
+					nativeEndian.PutUint32(row[4*x:], uint32(p[3])<<24|uint32(p[0])<<16|uint32(p[1])<<8|uint32(p[2]))
+				}
+			}
+		case *image.NRGBA:
+			for y := 0; y < b.Dy(); y++ {
+				row := data[y*stride:]
+				in := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+				for x := 0; x < b.Dx(); x++ {
+					p := in[4*x : 4*x+4]
+					// Premultiply the same way color.NRGBA does.
+					a := uint32(p[3]) * 0x101
+					r := uint32(p[0]) * 0x101 * a / 0xffff >> 8
+					g := uint32(p[1]) * 0x101 * a / 0xffff >> 8
+					bl := uint32(p[2]) * 0x101 * a / 0xffff >> 8
+					nativeEndian.PutUint32(row[4*x:], a>>8<<24|r<<16|g<<8|bl)
+				}
+			}
+		case *image.Gray:
+			for y := 0; y < b.Dy(); y++ {
+				row := data[y*stride:]
+				in := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+				for x := 0; x < b.Dx(); x++ {
+					v := uint32(in[x])
+					nativeEndian.PutUint32(row[4*x:], 0xff000000|v<<16|v<<8|v)
+				}
+			}
+		default:
+			for y := 0; y < b.Dy(); y++ {
+				row := data[y*stride:]
+				for x := 0; x < b.Dx(); x++ {
+					c := color.RGBAModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.RGBA)
+					nativeEndian.PutUint32(row[4*x:], uint32(c.A)<<24|uint32(c.R)<<16|uint32(c.G)<<8|uint32(c.B))
+				}
+			}
+		}
+	})
+	return surface
+}
+
+// ToRGBA copies the surface into a new image.RGBA.
+func (i *ImageSurface) ToRGBA() *image.RGBA {
+	p := i.pixels()
+	dst := image.NewRGBA(image.Rect(0, 0, p.width, p.height))
+	if p.data == nil {
+		return dst
+	}
+	for y := 0; y < p.height; y++ {
+		row := p.data[y*p.stride:]
+		out := dst.Pix[y*dst.Stride:]
+		switch p.format {
+		case FormatARGB32, FormatRGB24:
+			for x := 0; x < p.width; x++ {
+				v := nativeEndian.Uint32(row[4*x:])
+				a := uint8(v >> 24)
+				if p.format == FormatRGB24 {
+					a = 0xff
+				}
+				out[4*x+0] = uint8(v >> 16)
+				out[4*x+1] = uint8(v >> 8)
+				out[4*x+2] = uint8(v)
+				out[4*x+3] = a
+			}
+		case FormatA8:
+			for x := 0; x < p.width; x++ {
+				a := row[x]
+				out[4*x+0] = a
+				out[4*x+1] = a
+				out[4*x+2] = a
+				out[4*x+3] = a
+			}
+		case FormatA1:
+			for x := 0; x < p.width; x++ {
+				if a1At(row, x) {
+					out[4*x+0] = 0xff
+					out[4*x+1] = 0xff
+					out[4*x+2] = 0xff
+					out[4*x+3] = 0xff
+				}
+			}
+		default:
+			for x := 0; x < p.width; x++ {
+				c := color.RGBAModel.Convert(p.at(x, y)).(color.RGBA)
+				out[4*x+0] = c.R
+				out[4*x+1] = c.G
+				out[4*x+2] = c.B
+				out[4*x+3] = c.A
+			}
+		}
+	}
+	return dst
+}
+
+// ToNRGBA copies the surface into a new image.NRGBA, undoing cairo's
+// premultiplied alpha.
+func (i *ImageSurface) ToNRGBA() *image.NRGBA {
+	p := i.pixels()
+	dst := image.NewNRGBA(image.Rect(0, 0, p.width, p.height))
+	if p.data == nil {
+		return dst
+	}
+	for y := 0; y < p.height; y++ {
+		row := p.data[y*p.stride:]
+		out := dst.Pix[y*dst.Stride:]
+		switch p.format {
+		case FormatARGB32, FormatRGB24:
+			for x := 0; x < p.width; x++ {
+				v := nativeEndian.Uint32(row[4*x:])
+				r, g, b, a := v>>16&0xff, v>>8&0xff, v&0xff, v>>24
+				if p.format == FormatRGB24 {
+					a = 0xff
+				}
+				if a != 0xff && a != 0 {
+					// Unpremultiply the same way color.NRGBAModel does.
+					a16 := a * 0x101
+					r = r * 0x101 * 0xffff / a16 >> 8
+					g = g * 0x101 * 0xffff / a16 >> 8
+					b = b * 0x101 * 0xffff / a16 >> 8
+				}
+				out[4*x+0] = uint8(r)
+				out[4*x+1] = uint8(g)
+				out[4*x+2] = uint8(b)
+				out[4*x+3] = uint8(a)
+			}
+		case FormatA8:
+			// Unpremultiplied, any visible alpha pixel is white.
+			for x := 0; x < p.width; x++ {
+				if a := row[x]; a != 0 {
+					out[4*x+0] = 0xff
+					out[4*x+1] = 0xff
+					out[4*x+2] = 0xff
+					out[4*x+3] = a
+				}
+			}
+		case FormatA1:
+			for x := 0; x < p.width; x++ {
+				if a1At(row, x) {
+					out[4*x+0] = 0xff
+					out[4*x+1] = 0xff
+					out[4*x+2] = 0xff
+					out[4*x+3] = 0xff
+				}
+			}
+		default:
+			for x := 0; x < p.width; x++ {
+				c := color.NRGBAModel.Convert(p.at(x, y)).(color.NRGBA)
+				out[4*x+0] = c.R
+				out[4*x+1] = c.G
+				out[4*x+2] = c.B
+				out[4*x+3] = c.A
+			}
+		}
+	}
+	return dst
+}
+
+// maxImageSize is the largest width or height of an image surface.
+const maxImageSize = 32767
+
+// ImageSurfaceDecode decodes an image with image.Decode and copies it
+// into a new ImageSurface.  It also returns the name of the format, as
+// image.Decode does.  GIF, JPEG and PNG are always supported; import
+// other decoders to register more formats.  Images too large for cairo
+// fail with StatusInvalidSize.
+func ImageSurfaceDecode(r io.Reader) (surface *ImageSurface, format string, err error) {
+	img, format, err := image.Decode(r)
+	if err != nil {
+		return nil, "", err
+	}
+	if b := img.Bounds(); b.Dx() > maxImageSize || b.Dy() > maxImageSize {
+		return nil, "", StatusInvalidSize
+	}
+	defer catchStatus(&err)
+	return ImageSurfaceFromImage(img), format, nil
+}
+
+// EncodeOptions holds the settings for ImageSurface.Encode.  The zero
+// value uses the defaults of the Go encoders.
+type EncodeOptions struct {
+	// JPEGQuality is the quality of "jpeg" output, from 1 to 100.  Zero
+	// means jpeg.DefaultQuality.
+	JPEGQuality int
+
+	// PNGCompression is the compression level of "png" output.
+	PNGCompression png.CompressionLevel
+}
+
+// Encode writes the surface to w as an image in format, which is one of
+// the names returned by ImageSurfaceDecode: "gif", "jpeg" or "png".
+// opts may be nil.  Unlike WriteToPNG, this uses Go's encoders.
+func (i *ImageSurface) Encode(w io.Writer, format string, opts *EncodeOptions) error {
+	if opts == nil {
+		opts = &EncodeOptions{}
+	}
+	switch format {
+	case "gif":
+		return gif.Encode(w, i.ToRGBA(), nil)
+	case "jpeg":
+		quality := opts.JPEGQuality
+		if quality == 0 {
+			quality = jpeg.DefaultQuality
+		}
+		return jpeg.Encode(w, i.ToRGBA(), &jpeg.Options{Quality: quality})
+	case "png":
+		enc := &png.Encoder{CompressionLevel: opts.PNGCompression}
+		return enc.Encode(w, i.ToNRGBA())
+	}
+	return fmt.Errorf("cairo: unknown image format %q", format)
+}
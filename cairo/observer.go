@@ -0,0 +1,36 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+/*
+#include <cairo.h>
+*/
+import "C"
+
+// SurfaceObserverCallback is called by a SurfaceObserver once it has
+// passed an operation on to target, for example to time operations
+// with SurfaceObserver.Elapsed.  The Surfaces are only valid for the
+// duration of the call.  cairo has no way to take an error from the
+// callback, so a Status it panics with is dropped.
+type SurfaceObserverCallback func(observer, target *Surface)
+
+//export gocairoSurfaceObserverCallback
+func gocairoSurfaceObserverCallback(key C.int, observer, target *C.cairo_surface_t) {
+	f := goPointers.get(key).(SurfaceObserverCallback)
+	runCallback(StatusSuccess, func() error {
+		f(&Surface{observer}, &Surface{target})
+		return nil
+	})
+}
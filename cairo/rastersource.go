@@ -0,0 +1,129 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+/*
+#include <cairo.h>
+*/
+import "C"
+
+import (
+	"image"
+	"sync/atomic"
+)
+
+// RasterSource supplies the pixels of a pattern from
+// RasterSourcePatternCreate on demand, so that e.g. huge virtual images
+// need only be generated for the area cairo samples.  See
+// http://cairographics.org/manual/cairo-Raster-Sources.html for details.
+//
+// The Surfaces passed to the methods are only valid for the duration of
+// the call.  Returning a Status as the error, or panicking with one,
+// reports that status to cairo.  Any other error is reported as
+// StatusReadError.
+type RasterSource interface {
+	// Acquire returns a surface holding the pixels of the pattern within
+	// extents, which are in the pattern's space.  target is the surface
+	// being drawn to, for creating a similar surface.
+	Acquire(target *Surface, extents image.Rectangle) *Surface
+
+	// Release is called once cairo is done with a surface from Acquire.
+	Release(surface *Surface)
+
+	// Snapshot is called when cairo needs the pattern to stop changing,
+	// such as when recording it to a RecordingSurface.
+	Snapshot() error
+
+	// Copy is called when cairo copies the pattern.  The copy shares
+	// this RasterSource.
+	Copy() error
+
+	// Finish is called as the pattern, or a copy of it, is destroyed.
+	Finish()
+}
+
+// rasterSourceClosure is stashed in goPointers for the callbacks of a
+// raster source pattern.
+type rasterSourceClosure struct {
+	source        RasterSource
+	width, height int
+	// refs counts the pattern and its copies, which share the closure.
+	refs int32
+}
+
+func getRasterSource(key C.int) *rasterSourceClosure {
+	return goPointers.get(key).(*rasterSourceClosure)
+}
+
+//export gocairoRasterSourceAcquire
+func gocairoRasterSourceAcquire(key C.int, target *C.cairo_surface_t, extents *C.cairo_rectangle_int_t) *C.cairo_surface_t {
+	rs := getRasterSource(key)
+	r := image.Rect(0, 0, rs.width, rs.height)
+	if extents != nil {
+		r = image.Rect(int(extents.x), int(extents.y), int(extents.x+extents.width), int(extents.y+extents.height))
+	}
+	var surface *Surface
+	status := runCallback(StatusReadError, func() error {
+		surface = rs.source.Acquire(&Surface{target}, r)
+		return nil
+	})
+	if status != C.CAIRO_STATUS_SUCCESS || surface == nil {
+		return nil
+	}
+	// gocairo_raster_source_release drops this reference.
+	return C.cairo_surface_reference(surface.Ptr)
+}
+
+//export gocairoRasterSourceRelease
+func gocairoRasterSourceRelease(key C.int, surface *C.cairo_surface_t) {
+	rs := getRasterSource(key)
+	runCallback(StatusReadError, func() error {
+		rs.source.Release(&Surface{surface})
+		return nil
+	})
+}
+
+//export gocairoRasterSourceSnapshot
+func gocairoRasterSourceSnapshot(key C.int) C.cairo_status_t {
+	return runCallback(StatusReadError, getRasterSource(key).source.Snapshot)
+}
+
+//export gocairoRasterSourceCopy
+func gocairoRasterSourceCopy(key C.int) C.cairo_status_t {
+	rs := getRasterSource(key)
+	status := runCallback(StatusReadError, rs.source.Copy)
+	if status == C.CAIRO_STATUS_SUCCESS {
+		// cairo only finishes copies that succeed.
+		atomic.AddInt32(&rs.refs, 1)
+	}
+	return status
+}
+
+// gocairoRasterSourceFinish returns 1 once the last pattern sharing key
+// is finished, so the key can be freed.
+//
+//export gocairoRasterSourceFinish
+func gocairoRasterSourceFinish(key C.int) C.int {
+	rs := getRasterSource(key)
+	runCallback(StatusReadError, func() error {
+		rs.source.Finish()
+		return nil
+	})
+	if atomic.AddInt32(&rs.refs, -1) > 0 {
+		return 0
+	}
+	goPointers.clear(key)
+	return 1
+}
@@ -0,0 +1,33 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+import "image"
+
+// RectangleIntFromImage converts an image.Rectangle to a RectangleInt.
+func RectangleIntFromImage(r image.Rectangle) RectangleInt {
+	r = r.Canon()
+	return RectangleInt{
+		X:      int32(r.Min.X),
+		Y:      int32(r.Min.Y),
+		Width:  int32(r.Dx()),
+		Height: int32(r.Dy()),
+	}
+}
+
+// ImageRectangle converts r to an image.Rectangle.
+func (r RectangleInt) ImageRectangle() image.Rectangle {
+	return image.Rect(int(r.X), int(r.Y), int(r.X+r.Width), int(r.Y+r.Height))
+}
@@ -0,0 +1,38 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+import "testing"
+
+func TestRegionGetRectangle(t *testing.T) {
+	want := RectangleInt{X: 1, Y: 2, Width: 3, Height: 4}
+	region := RegionCreateRectangle(&want)
+	defer region.Close()
+
+	if got := region.GetRectangle(0); got != want {
+		t.Errorf("GetRectangle(0) = %+v, want %+v", got, want)
+	}
+
+	for _, nth := range []int{-1, 1} {
+		func() {
+			defer func() {
+				if r := recover(); r != StatusInvalidIndex {
+					t.Errorf("GetRectangle(%d) panicked with %v, want %v", nth, r, StatusInvalidIndex)
+				}
+			}()
+			region.GetRectangle(nth)
+		}()
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Tag names for Context.TagBegin.  TagLink and TagDest create links and
+// their destinations; the others are PDF structure types, which mark up
+// the logical structure of a document and take no attributes.  See
+// http://cairographics.org/manual/cairo-Tags-and-Links.html for details.
+const (
+	TagLink = "Link"       // CAIRO_TAG_LINK
+	TagDest = "cairo.dest" // CAIRO_TAG_DEST
+
+	TagDocument = "Document"
+	TagPart     = "Part"
+	TagSect     = "Sect"
+	TagDiv      = "Div"
+	TagH1       = "H1"
+	TagH2       = "H2"
+	TagH3       = "H3"
+	TagH4       = "H4"
+	TagH5       = "H5"
+	TagH6       = "H6"
+	TagP        = "P"
+	TagL        = "L"
+	TagLI       = "LI"
+	TagTable    = "Table"
+	TagTR       = "TR"
+	TagTH       = "TH"
+	TagTD       = "TD"
+	TagSpan     = "Span"
+	TagFigure   = "Figure"
+	TagCaption  = "Caption"
+)
+
+// TagAttributes are the attributes of a tag passed to Context.TagBegin.
+// String returns them in cairo's attribute syntax, such as
+// "uri='http://cairographics.org' rect=[10 10 100 20]".
+type TagAttributes interface {
+	String() string
+}
+
+// LinkAttributes are the attributes of a TagLink.  Set URI for a link to
+// a web page, Dest for a link to a named destination, or Page and
+// optionally Pos for a link to a position in the document.  Setting File
+// as well makes Dest, Page and Pos refer to another PDF file.
+type LinkAttributes struct {
+	URI  string
+	File string
+	Dest string
+
+	// Page is the 1-based page to link to, or 0 for none.
+	Page int
+	// Pos is the position on Page to link to, in PDF user space.
+	Pos *PathPoint
+
+	// Rects are the areas of the link.  If empty, cairo uses the
+	// extents of the drawing between TagBegin and TagEnd.
+	Rects []Rectangle
+}
+
+func (a LinkAttributes) String() string {
+	var b tagAttributesBuilder
+	b.str("uri", a.URI)
+	b.str("file", a.File)
+	b.str("dest", a.Dest)
+	if a.Page > 0 {
+		b.add("page", fmt.Sprint(a.Page))
+	}
+	if a.Pos != nil {
+		b.floats("pos", a.Pos.X, a.Pos.Y)
+	}
+	if len(a.Rects) > 0 {
+		var fs []float64
+		for _, r := range a.Rects {
+			fs = append(fs, r.X, r.Y, r.Width, r.Height)
+		}
+		b.floats("rect", fs...)
+	}
+	return b.String()
+}
+
+// DestAttributes are the attributes of a TagDest, which names a position
+// for a LinkAttributes.Dest to link to.  If X and Y are both zero, the
+// destination is the top left of the drawing between TagBegin and
+// TagEnd.  Internal destinations aren't exported for links from other
+// files.
+type DestAttributes struct {
+	Name     string
+	X, Y     float64
+	Internal bool
+}
+
+func (a DestAttributes) String() string {
+	var b tagAttributesBuilder
+	b.str("name", a.Name)
+	if a.X != 0 || a.Y != 0 {
+		b.floats("x", a.X)
+		b.floats("y", a.Y)
+	}
+	if a.Internal {
+		b.add("internal", "true")
+	}
+	return b.String()
+}
+
+// tagAttributesBuilder formats attributes in cairo's syntax.
+type tagAttributesBuilder struct {
+	attrs []string
+}
+
+func (b *tagAttributesBuilder) add(name, value string) {
+	b.attrs = append(b.attrs, name+"="+value)
+}
+
+// str adds a quoted string attribute, unless value is empty.
+func (b *tagAttributesBuilder) str(name, value string) {
+	if value == "" {
+		return
+	}
+	value = strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+	b.add(name, "'"+value+"'")
+}
+
+// floats adds a number attribute, or an array attribute if given more
+// than one value.
+func (b *tagAttributesBuilder) floats(name string, values ...float64) {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = fmt.Sprint(v)
+	}
+	if len(values) == 1 {
+		b.add(name, s[0])
+		return
+	}
+	b.add(name, "["+strings.Join(s, " ")+"]")
+}
+
+func (b *tagAttributesBuilder) String() string {
+	return strings.Join(b.attrs, " ")
+}
@@ -0,0 +1,125 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cairo
+
+/*
+#include <cairo.h>
+*/
+import "C"
+
+import "unsafe"
+
+// UserFont implements the glyphs of a font face from UserFontFaceCreate.
+// Its methods are cairo's user font callbacks; see
+// http://cairographics.org/manual/cairo-User-Fonts.html for details.
+//
+// The ScaledFont and Context passed to the methods are only valid for
+// the duration of the call.  Returning a Status as the error, or
+// panicking with one, reports that status to cairo.  Any other error
+// is reported as StatusUserFontError.
+type UserFont interface {
+	// Init is called once for each new ScaledFont and may fill in
+	// extents.  cr has the scaled font's scale matrix applied.
+	Init(scaledFont *ScaledFont, cr *Context, extents *FontExtents) error
+
+	// RenderGlyph draws glyph to cr and fills in its advance in extents.
+	RenderGlyph(scaledFont *ScaledFont, glyph uint32, cr *Context, extents *TextExtents) error
+
+	// UnicodeToGlyph maps a character to a glyph index.  Returning
+	// StatusUserFontNotImplemented uses the character as the index.
+	UnicodeToGlyph(scaledFont *ScaledFont, unicode rune) (uint32, error)
+
+	// TextToGlyphs converts text to positioned glyphs.  If wantClusters
+	// is set, cairo also needs the clusters mapping the glyphs back to
+	// the text, e.g. when rendering to PDF, and returning none fails
+	// with StatusInvalidClusters; otherwise the clusters are ignored.
+	// Returning StatusUserFontNotImplemented falls back to
+	// UnicodeToGlyph.
+	TextToGlyphs(scaledFont *ScaledFont, utf8 string, wantClusters bool) ([]Glyph, []TextCluster, TextClusterFlags, error)
+}
+
+// userFontCall calls f with the UserFont stashed under key.
+func userFontCall(key C.int, f func(font UserFont) error) C.cairo_status_t {
+	font := goPointers.get(key).(UserFont)
+	return runCallback(StatusUserFontError, func() error {
+		return f(font)
+	})
+}
+
+//export gocairoUserFontInit
+func gocairoUserFontInit(key C.int, scaledFont *C.cairo_scaled_font_t, cr *C.cairo_t, extents *C.cairo_font_extents_t) C.cairo_status_t {
+	return userFontCall(key, func(font UserFont) error {
+		return font.Init(&ScaledFont{scaledFont}, &Context{cr}, (*FontExtents)(unsafe.Pointer(extents)))
+	})
+}
+
+//export gocairoUserFontRenderGlyph
+func gocairoUserFontRenderGlyph(key C.int, scaledFont *C.cairo_scaled_font_t, glyph C.ulong, cr *C.cairo_t, extents *C.cairo_text_extents_t) C.cairo_status_t {
+	return userFontCall(key, func(font UserFont) error {
+		return font.RenderGlyph(&ScaledFont{scaledFont}, uint32(glyph), &Context{cr}, (*TextExtents)(unsafe.Pointer(extents)))
+	})
+}
+
+//export gocairoUserFontUnicodeToGlyph
+func gocairoUserFontUnicodeToGlyph(key C.int, scaledFont *C.cairo_scaled_font_t, unicode C.ulong, glyphIndex *C.ulong) C.cairo_status_t {
+	return userFontCall(key, func(font UserFont) error {
+		index, err := font.UnicodeToGlyph(&ScaledFont{scaledFont}, rune(unicode))
+		if err == nil {
+			*glyphIndex = C.ulong(index)
+		}
+		return err
+	})
+}
+
+//export gocairoUserFontTextToGlyphs
+func gocairoUserFontTextToGlyphs(key C.int, scaledFont *C.cairo_scaled_font_t, utf8 *C.char, utf8Len C.int, cGlyphs **C.cairo_glyph_t, cNumGlyphs *C.int, cClusters **C.cairo_text_cluster_t, cNumClusters *C.int, cFlags *C.cairo_text_cluster_flags_t) C.cairo_status_t {
+	return userFontCall(key, func(font UserFont) error {
+		// A nil clusters pointer means the caller doesn't want them.
+		wantClusters := cClusters != nil
+		glyphs, clusters, flags, err := font.TextToGlyphs(&ScaledFont{scaledFont}, C.GoStringN(utf8, utf8Len), wantClusters)
+		if err != nil {
+			return err
+		}
+
+		// cairo passes in buffers to fill, which we replace with ones
+		// from cairo's allocators if they're too small.
+		if len(glyphs) > int(*cNumGlyphs) {
+			*cGlyphs = C.cairo_glyph_allocate(C.int(len(glyphs)))
+		}
+		*cNumGlyphs = C.int(len(glyphs))
+		if len(glyphs) > 0 {
+			out := (*[1 << 30]C.cairo_glyph_t)(unsafe.Pointer(*cGlyphs))[:len(glyphs):len(glyphs)]
+			for i, g := range glyphs {
+				out[i].index = C.ulong(g.Index)
+				out[i].x = C.double(g.X)
+				out[i].y = C.double(g.Y)
+			}
+		}
+
+		if !wantClusters {
+			return nil
+		}
+		if len(clusters) > int(*cNumClusters) {
+			*cClusters = C.cairo_text_cluster_allocate(C.int(len(clusters)))
+		}
+		*cNumClusters = C.int(len(clusters))
+		if len(clusters) > 0 {
+			out := (*[1 << 30]TextCluster)(unsafe.Pointer(*cClusters))[:len(clusters):len(clusters)]
+			copy(out, clusters)
+		}
+		*cFlags = C.cairo_text_cluster_flags_t(flags)
+		return nil
+	})
+}
@@ -17,10 +17,14 @@ package cairo
 import (
 	"io"
 	"reflect"
-	"sync"
 	"unsafe"
+
+	"github.com/martine/gocairo/internal/stash"
 )
 
+/*
+#include <cairo.h>
+*/
 import "C"
 
 // sliceBytes returns a pointer to the bytes of the data in a slice.
@@ -29,6 +33,14 @@ func sliceBytes(p unsafe.Pointer) unsafe.Pointer {
 	return unsafe.Pointer(hdr.Data)
 }
 
+// cBool converts a bool to the 0 or 1 that C expects.
+func cBool(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
 // toError converts a Status into a Go error.
 func (s Status) toError() error {
 	if s == StatusSuccess {
@@ -37,39 +49,59 @@ func (s Status) toError() error {
 	return s
 }
 
-// In Go 1.6, you're not allowed to pass Go pointers through C.
-// To work around this, use a map keyed by integers for stashing
-// arbitrary Go data.
-type goPointerStash struct {
-	sync.Mutex
-	data    map[C.int]interface{}
-	nextKey C.int
+// runCallback runs f on behalf of cairo and converts its error, or a
+// Status it panics with, to a status for cairo.  Errors that aren't a
+// Status are reported as otherErr.
+func runCallback(otherErr Status, f func() error) (status C.cairo_status_t) {
+	defer func() {
+		if r := recover(); r != nil {
+			s, ok := r.(Status)
+			if !ok {
+				panic(r)
+			}
+			status = C.cairo_status_t(s)
+		}
+	}()
+	switch err := f().(type) {
+	case nil:
+		return C.CAIRO_STATUS_SUCCESS
+	case Status:
+		return C.cairo_status_t(err)
+	default:
+		return C.cairo_status_t(otherErr)
+	}
 }
 
+// catchStatus recovers a Status that a function panicked with into
+// *err.  Defer it in functions that return errors instead of panicking.
+func catchStatus(err *error) {
+	if r := recover(); r != nil {
+		s, ok := r.(Status)
+		if !ok {
+			panic(r)
+		}
+		*err = s
+	}
+}
+
+// goPointers stashes the Go data behind cairo's callbacks, keyed by the
+// C ints that the callbacks get.
 var goPointers = &goPointerStash{}
 
+type goPointerStash struct {
+	stash stash.Stash
+}
+
 func (gp *goPointerStash) put(data interface{}) C.int {
-	gp.Lock()
-	defer gp.Unlock()
-	if gp.data == nil {
-		gp.data = make(map[C.int]interface{})
-	}
-	key := gp.nextKey
-	gp.nextKey++
-	gp.data[key] = data
-	return key
+	return C.int(gp.stash.Put(data))
 }
 
 func (gp *goPointerStash) get(key C.int) interface{} {
-	gp.Lock()
-	defer gp.Unlock()
-	return gp.data[key]
+	return gp.stash.Get(int(key))
 }
 
 func (gp *goPointerStash) clear(key C.int) {
-	gp.Lock()
-	defer gp.Unlock()
-	delete(gp.data, key)
+	gp.stash.Clear(int(key))
 }
 
 type writeClosure struct {
@@ -99,3 +131,8 @@ func gocairoReadFunc(key C.int, data unsafe.Pointer, clength C.uint) bool {
 	_, readClosure.err = io.ReadFull(readClosure.r, buf)
 	return readClosure.err == nil
 }
+
+//export gocairoFreeKey
+func gocairoFreeKey(key C.int) {
+	goPointers.clear(key)
+}
@@ -0,0 +1,120 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command cairo-replay renders a cairo script trace, such as one written
+// by a cairo.ScriptDevice, to a PNG or PDF file.
+//
+// Usage:
+//
+//	cairo-replay -o out.png trace.cs
+//
+// The format follows from the extension of the output file.  The first
+// surface the trace creates is the one written out; pass "-" as the
+// trace to read it from stdin.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/martine/gocairo/cairo"
+	"github.com/martine/gocairo/csi"
+)
+
+func main() {
+	out := flag.String("o", "out.png", "output `file`, ending in .png or .pdf")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [-o out.png] trace.cs\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	trace := flag.Arg(0)
+
+	ext := strings.ToLower(filepath.Ext(*out))
+	if ext != ".png" && ext != ".pdf" {
+		log.Fatalf("%s: unknown output format %q", *out, ext)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	var target *cairo.Surface
+	var image *cairo.ImageSurface
+	hooks := &csi.Hooks{
+		SurfaceCreate: func(content cairo.Content, width, height float64, uid int64) *cairo.Surface {
+			if target != nil {
+				return target.CreateSimilar(content, int(math.Ceil(width)), int(math.Ceil(height)))
+			}
+			if ext == ".pdf" {
+				target = cairo.PDFSurfaceCreateForStream(f, width, height).Surface
+			} else {
+				image = cairo.ImageSurfaceCreate(format(content), int(math.Ceil(width)), int(math.Ceil(height)))
+				target = image.Surface
+			}
+			return target
+		},
+	}
+
+	interp := csi.Create(hooks)
+	if trace == "-" {
+		err = interp.FeedStream(os.Stdin)
+	} else {
+		err = interp.Run(trace)
+	}
+	if err != nil {
+		log.Fatalf("%s:%d: %s", trace, interp.LineNumber(), err)
+	}
+	if err := interp.Close(); err != nil {
+		log.Fatalf("%s: %s", trace, err)
+	}
+	if target == nil {
+		log.Fatalf("%s: trace creates no surface", trace)
+	}
+
+	if image != nil {
+		err = image.WriteToPNG(f)
+	} else {
+		// Finishing writes out the rest of the PDF.
+		target.Finish()
+	}
+	if err == nil {
+		err = f.Close()
+	}
+	if err != nil {
+		log.Fatalf("%s: %s", *out, err)
+	}
+}
+
+// format returns the image format for surfaces of content.
+func format(content cairo.Content) cairo.Format {
+	switch content {
+	case cairo.ContentColor:
+		return cairo.FormatRGB24
+	case cairo.ContentAlpha:
+		return cairo.FormatA8
+	default:
+		return cairo.FormatARGB32
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package csi wraps the cairo script interpreter, which replays the
+// traces written by a cairo.ScriptDevice.
+package csi
+
+/*
+#cgo pkg-config: cairo-script-interpreter
+#include <cairo-script-interpreter.h>
+#include <stdlib.h>
+
+cairo_surface_t *gocsiSurfaceCreate(void *key, cairo_content_t content,
+                                    double width, double height, long uid);
+cairo_t *gocsiContextCreate(void *key, cairo_surface_t *surface);
+void gocsiShowPage(void *key, cairo_t *cr);
+void gocsiCopyPage(void *key, cairo_t *cr);
+
+// The interpreter hooks, which forward to the Hooks behind closure, a
+// key from newKey.
+static cairo_surface_t *gocsi_surface_create(void *closure,
+                                             cairo_content_t content,
+                                             double width, double height,
+                                             long uid) {
+  return gocsiSurfaceCreate(closure, content, width, height, uid);
+}
+
+static cairo_t *gocsi_context_create(void *closure,
+                                     cairo_surface_t *surface) {
+  return gocsiContextCreate(closure, surface);
+}
+
+static void gocsi_show_page(void *closure, cairo_t *cr) {
+  gocsiShowPage(closure, cr);
+}
+
+static void gocsi_copy_page(void *closure, cairo_t *cr) {
+  gocsiCopyPage(closure, cr);
+}
+
+// Installs the hooks that are set, leaving the others to the
+// interpreter's defaults.
+static void gocsi_install_hooks(cairo_script_interpreter_t *csi, void *key,
+                                int surface_create, int context_create,
+                                int show_page, int copy_page) {
+  cairo_script_interpreter_hooks_t hooks = { 0 };
+  hooks.closure = key;
+  if (surface_create)
+    hooks.surface_create = gocsi_surface_create;
+  if (context_create)
+    hooks.context_create = gocsi_context_create;
+  if (show_page)
+    hooks.show_page = gocsi_show_page;
+  if (copy_page)
+    hooks.copy_page = gocsi_copy_page;
+  cairo_script_interpreter_install_hooks(csi, &hooks);
+}
+*/
+import "C"
+
+import (
+	"io"
+	"io/ioutil"
+	"runtime"
+	"unsafe"
+
+	"github.com/martine/gocairo/cairo"
+)
+
+// Hooks let the caller take over parts of replaying a trace, such as
+// choosing the surfaces it draws to.  Nil hooks are left to the
+// interpreter.
+type Hooks struct {
+	// SurfaceCreate returns the surface to use for a surface the trace
+	// creates, which the trace refers to by uid.
+	SurfaceCreate func(content cairo.Content, width, height float64, uid int64) *cairo.Surface
+
+	// ContextCreate returns the context to use for a context the trace
+	// creates on surface.
+	ContextCreate func(surface *cairo.Surface) *cairo.Context
+
+	// ShowPage and CopyPage are called for the trace's show-page and
+	// copy-page operations on cr.
+	ShowPage func(cr *cairo.Context)
+	CopyPage func(cr *cairo.Context)
+}
+
+// Interpreter replays cairo script traces.
+type Interpreter struct {
+	ptr *C.cairo_script_interpreter_t
+	// key holds the Hooks, or is nil if there are none.
+	key unsafe.Pointer
+}
+
+// Create returns a new Interpreter calling hooks, which may be nil.
+// See cairo_script_interpreter_create().
+func Create(hooks *Hooks) *Interpreter {
+	csi := &Interpreter{ptr: C.cairo_script_interpreter_create()}
+	if hooks != nil {
+		csi.key = newKey(hooks)
+		C.gocsi_install_hooks(csi.ptr, csi.key,
+			cBool(hooks.SurfaceCreate != nil), cBool(hooks.ContextCreate != nil),
+			cBool(hooks.ShowPage != nil), cBool(hooks.CopyPage != nil))
+	}
+	runtime.SetFinalizer(csi, (*Interpreter).Close)
+	return csi
+}
+
+func cBool(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func toError(status C.cairo_status_t) error {
+	if status != C.CAIRO_STATUS_SUCCESS {
+		return cairo.Status(status)
+	}
+	return nil
+}
+
+// Run replays the trace in the file filename.
+// See cairo_script_interpreter_run().
+func (csi *Interpreter) Run(filename string) error {
+	if csi.ptr == nil {
+		panic(cairo.StatusNullPointer)
+	}
+	cFilename := C.CString(filename)
+	defer C.free(unsafe.Pointer(cFilename))
+	return toError(C.cairo_script_interpreter_run(csi.ptr, cFilename))
+}
+
+// FeedStream replays the trace read from r.  The interpreter only scans
+// whole traces, so all of r is read before replaying it.
+func (csi *Interpreter) FeedStream(r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return csi.FeedString(string(data))
+}
+
+// FeedString replays the trace in script.
+// See cairo_script_interpreter_feed_string().
+func (csi *Interpreter) FeedString(script string) error {
+	if csi.ptr == nil {
+		panic(cairo.StatusNullPointer)
+	}
+	cScript := C.CString(script)
+	defer C.free(unsafe.Pointer(cScript))
+	return toError(C.cairo_script_interpreter_feed_string(csi.ptr, cScript, C.int(len(script))))
+}
+
+// LineNumber returns the line of the trace being replayed, for error
+// messages.
+// See cairo_script_interpreter_get_line_number().
+func (csi *Interpreter) LineNumber() int {
+	if csi.ptr == nil {
+		panic(cairo.StatusNullPointer)
+	}
+	return int(C.cairo_script_interpreter_get_line_number(csi.ptr))
+}
+
+// Finish releases the surfaces and contexts of the traces replayed so
+// far, after which the interpreter can't be used.
+// See cairo_script_interpreter_finish().
+func (csi *Interpreter) Finish() error {
+	if csi.ptr == nil {
+		panic(cairo.StatusNullPointer)
+	}
+	return toError(C.cairo_script_interpreter_finish(csi.ptr))
+}
+
+// Close releases the interpreter without waiting for the garbage
+// collector, returning the first error it hit, if any.  It is safe to
+// call Close more than once.
+// See cairo_script_interpreter_destroy().
+func (csi *Interpreter) Close() error {
+	if csi.ptr == nil {
+		return nil
+	}
+	err := toError(C.cairo_script_interpreter_destroy(csi.ptr))
+	csi.ptr = nil
+	if csi.key != nil {
+		freeKey(csi.key)
+		csi.key = nil
+	}
+	runtime.SetFinalizer(csi, nil)
+	return err
+}
@@ -0,0 +1,104 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi
+
+/*
+#include <cairo.h>
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/martine/gocairo/cairo"
+	"github.com/martine/gocairo/internal/stash"
+)
+
+// hooksStash holds the Hooks behind each interpreter's hook closure.
+var hooksStash stash.Stash
+
+// newKey stashes hooks under a key in C memory, for as long as the
+// interpreter lives.  Release it with freeKey.
+func newKey(hooks *Hooks) unsafe.Pointer {
+	key := (*C.int)(C.malloc(C.size_t(unsafe.Sizeof(C.int(0)))))
+	*key = C.int(hooksStash.Put(hooks))
+	return unsafe.Pointer(key)
+}
+
+func freeKey(key unsafe.Pointer) {
+	hooksStash.Clear(int(*(*C.int)(key)))
+	C.free(key)
+}
+
+func getHooks(key unsafe.Pointer) *Hooks {
+	return hooksStash.Get(int(*(*C.int)(key))).(*Hooks)
+}
+
+// runHook runs f on behalf of the interpreter.  Hooks have no way to
+// return an error, so if f panics with a cairo.Status the hook returns
+// nothing instead, which the interpreter reports as an error of its own.
+// Other panics are re-raised, as in the cairo package's callbacks.
+func runHook(f func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(cairo.Status); !ok {
+				panic(r)
+			}
+		}
+	}()
+	f()
+}
+
+//export gocsiSurfaceCreate
+func gocsiSurfaceCreate(key unsafe.Pointer, content C.cairo_content_t, width, height C.double, uid C.long) (ret *C.cairo_surface_t) {
+	hooks := getHooks(key)
+	runHook(func() {
+		surface := hooks.SurfaceCreate(cairo.Content(content), float64(width), float64(height), int64(uid))
+		if surface != nil {
+			// The interpreter owns the surface it gets back.
+			ret = C.cairo_surface_reference((*C.cairo_surface_t)(unsafe.Pointer(surface.Ptr)))
+		}
+	})
+	return ret
+}
+
+//export gocsiContextCreate
+func gocsiContextCreate(key unsafe.Pointer, surface *C.cairo_surface_t) (ret *C.cairo_t) {
+	hooks := getHooks(key)
+	runHook(func() {
+		cr := hooks.ContextCreate(cairo.BorrowSurface(unsafe.Pointer(surface)))
+		if cr != nil {
+			ret = C.cairo_reference((*C.cairo_t)(unsafe.Pointer(cr.Ptr)))
+		}
+	})
+	return ret
+}
+
+//export gocsiShowPage
+func gocsiShowPage(key unsafe.Pointer, cr *C.cairo_t) {
+	hooks := getHooks(key)
+	runHook(func() {
+		hooks.ShowPage(cairo.BorrowContext(unsafe.Pointer(cr)))
+	})
+}
+
+//export gocsiCopyPage
+func gocsiCopyPage(key unsafe.Pointer, cr *C.cairo_t) {
+	hooks := getHooks(key)
+	runHook(func() {
+		hooks.CopyPage(cairo.BorrowContext(unsafe.Pointer(cr)))
+	})
+}
@@ -0,0 +1,30 @@
+/* Copyright 2015 Google Inc. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+  This file contains fake definitions of FreeType and fontconfig types.
+  This is used to keep the C parser happy when parsing cairo-ft.h; we
+  don't want to bring in all the FreeType types into the binding!
+*/
+
+/* Set the #defines so that Cairo's includes of FreeType and fontconfig
+   don't do anything. */
+#define FT2BUILD_H_
+#define __FT2BUILD_H__
+#define FT_FREETYPE_H <stddef.h>
+#define _FONTCONFIG_H_
+
+typedef struct FT_FaceRec_ *FT_Face;
+typedef struct _FcPattern FcPattern;
@@ -17,11 +17,17 @@ package main
 import (
 	"bytes"
 	"encoding/xml"
+	"flag"
 	"fmt"
+	"go/ast"
 	"go/format"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"rsc.io/c2go/cc"
@@ -46,8 +52,42 @@ var intentionalSkip = map[string]string{
 	"cairo_glyph_allocate": "manage memory on the Go side",
 	"cairo_glyph_free":     "manage memory on the Go side",
 
+	"cairo_text_cluster_allocate": "manage memory on the Go side",
+	"cairo_text_cluster_free":     "manage memory on the Go side",
+
+	"cairo_user_font_face_set_init_func":             "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_set_render_glyph_func":     "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_set_text_to_glyphs_func":   "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_set_unicode_to_glyph_func": "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_get_init_func":             "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_get_render_glyph_func":     "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_get_text_to_glyphs_func":   "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_font_face_get_unicode_to_glyph_func": "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_scaled_font_init_func_t":             "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_scaled_font_render_glyph_func_t":     "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_scaled_font_text_to_glyphs_func_t":   "set by UserFontFaceCreate from a UserFont",
+	"cairo_user_scaled_font_unicode_to_glyph_func_t": "set by UserFontFaceCreate from a UserFont",
+
+	"cairo_raster_source_pattern_set_callback_data": "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_get_callback_data": "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_set_acquire":       "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_get_acquire":       "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_set_snapshot":      "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_get_snapshot":      "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_set_copy":          "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_get_copy":          "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_set_finish":        "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_pattern_get_finish":        "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_acquire_func_t":            "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_release_func_t":            "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_snapshot_func_t":           "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_copy_func_t":               "set by RasterSourcePatternCreate from a RasterSource",
+	"cairo_raster_source_finish_func_t":             "set by RasterSourcePatternCreate from a RasterSource",
+
 	"cairo_path_data_t": "used internally in path iteration",
 
+	"cairo_rectangle_list_t": "converted to a []Rectangle by ClipRectangles",
+
 	"cairo_debug_reset_static_data": "intended for use with valgrind, requires deterministic object destruction",
 
 	// These are fake types defined in fake-xlib.h.
@@ -56,43 +96,377 @@ var intentionalSkip = map[string]string{
 	"Display":  "",
 	"Visual":   "",
 	"Screen":   "",
+
+	// These are fake types defined in fake-ft.h.
+	"FT_Face":   "",
+	"FcPattern": "",
 }
 
 // skipUnhandled maps C names to the excuse why we haven't wrapped them yet.
-var skipUnhandled = map[string]string{
-	"cairo_pattern_get_rgba":                   "mix of out params and status",
-	"cairo_pattern_get_color_stop_rgba":        "mix of out params and status",
-	"cairo_pattern_get_color_stop_count":       "mix of out params and status",
-	"cairo_pattern_get_linear_points":          "mix of out params and status",
-	"cairo_pattern_get_radial_circles":         "mix of out params and status",
-	"cairo_mesh_pattern_get_patch_count":       "mix of out params and status",
-	"cairo_mesh_pattern_get_corner_color_rgba": "mix of out params and status",
-	"cairo_mesh_pattern_get_control_point":     "mix of out params and status",
+var skipUnhandled = map[string]string{}
+
+var typeTodoList = map[string]string{}
 
-	"cairo_scaled_font_text_to_glyphs": "fancy font APIs",
-	"cairo_surface_get_mime_data":      "mime functions",
-	"cairo_surface_set_mime_data":      "mime functions",
-	"cairo_pattern_get_surface":        "need to figure out refcounting",
+var manualImpl = map[string]string{
+	"cairo_image_surface_get_data": `func (i *ImageSurface) Data() []byte {
+	if i.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	buf := C.cairo_image_surface_get_data(i.Ptr)
+	return C.GoBytes(unsafe.Pointer(buf), C.int(i.GetStride()*i.GetHeight()))
+}`,
+
+	"cairo_user_font_face_create": `func UserFontFaceCreate(font UserFont) *UserFontFace {
+p := C.cairo_user_font_face_create()
+key := newKey(font)
+status := C.cairo_font_face_set_user_data(p, &C.gocairo_user_font_key,
+unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+if status != C.CAIRO_STATUS_SUCCESS {
+// Error font faces don't hold user data, so free it now.
+C.gocairo_free_key(unsafe.Pointer(key))
+}
+C.cairo_user_font_face_set_init_func(p, (C.cairo_user_scaled_font_init_func_t)(unsafe.Pointer(C.gocairo_user_font_init)))
+C.cairo_user_font_face_set_render_glyph_func(p, (C.cairo_user_scaled_font_render_glyph_func_t)(unsafe.Pointer(C.gocairo_user_font_render_glyph)))
+C.cairo_user_font_face_set_text_to_glyphs_func(p, (C.cairo_user_scaled_font_text_to_glyphs_func_t)(unsafe.Pointer(C.gocairo_user_font_text_to_glyphs)))
+C.cairo_user_font_face_set_unicode_to_glyph_func(p, (C.cairo_user_scaled_font_unicode_to_glyph_func_t)(unsafe.Pointer(C.gocairo_user_font_unicode_to_glyph)))
+ret := &UserFontFace{wrapFontFace(p)}
+if err := ret.status(); err != nil {
+panic(err)
 }
+return ret
+}`,
 
-var typeTodoList = map[string]string{
-	"cairo_rectangle_int_t":  "hard to wrap API",
-	"cairo_rectangle_list_t": "hard to wrap API",
+	"cairo_pattern_create_raster_source": `func RasterSourcePatternCreate(source RasterSource, content Content, width, height int) *RasterSourcePattern {
+key := newKey(&rasterSourceClosure{source: source, width: width, height: height, refs: 1})
+p := C.cairo_pattern_create_raster_source(unsafe.Pointer(key), C.cairo_content_t(content), C.int(width), C.int(height))
+C.cairo_raster_source_pattern_set_acquire(p, (C.cairo_raster_source_acquire_func_t)(unsafe.Pointer(C.gocairo_raster_source_acquire)), (C.cairo_raster_source_release_func_t)(unsafe.Pointer(C.gocairo_raster_source_release)))
+C.cairo_raster_source_pattern_set_snapshot(p, (C.cairo_raster_source_snapshot_func_t)(unsafe.Pointer(C.gocairo_raster_source_snapshot)))
+C.cairo_raster_source_pattern_set_copy(p, (C.cairo_raster_source_copy_func_t)(unsafe.Pointer(C.gocairo_raster_source_copy)))
+C.cairo_raster_source_pattern_set_finish(p, (C.cairo_raster_source_finish_func_t)(unsafe.Pointer(C.gocairo_raster_source_finish)))
+ret := &RasterSourcePattern{wrapPattern(p)}
+if err := ret.status(); err != nil {
+// Error patterns never call finish, so free the key now.
+C.gocairo_free_key(unsafe.Pointer(key))
+panic(err)
+}
+return ret
+}`,
 
-	// Fancy font APIs -- TODO.
-	"cairo_text_cluster_t": "needs work",
+	"cairo_surface_get_mime_data": `func (surface *Surface) MIMEData(mimeType string) []byte {
+if surface.Ptr == nil {
+panic(StatusNullPointer)
+}
+c_mimeType := C.CString(mimeType)
+defer C.free(unsafe.Pointer(c_mimeType))
+var data *C.uchar
+var length C.ulong
+C.cairo_surface_get_mime_data(surface.Ptr, c_mimeType, &data, &length)
+if err := surface.status(); err != nil {
+panic(err)
+}
+if data == nil {
+return nil
+}
+return C.GoBytes(unsafe.Pointer(data), C.int(length))
+}`,
 
-	// Raster sources -- TODO.
-	"cairo_raster_source_acquire_func_t":  "callbacks",
-	"cairo_raster_source_snapshot_func_t": "callbacks",
-	"cairo_raster_source_copy_func_t":     "callbacks",
-	"cairo_raster_source_finish_func_t":   "callbacks",
+	"cairo_surface_set_mime_data": `func (surface *Surface) SetMIMEData(mimeType string, data []byte) {
+if surface.Ptr == nil {
+panic(StatusNullPointer)
+}
+c_mimeType := C.CString(mimeType)
+defer C.free(unsafe.Pointer(c_mimeType))
+// cairo holds on to the data, so it gets a copy in C memory that it
+// frees once it's done with it.  nil data removes the MIME data.
+var c_data unsafe.Pointer
+var destroy C.cairo_destroy_func_t
+if data != nil {
+c_data = C.CBytes(data)
+destroy = (C.cairo_destroy_func_t)(unsafe.Pointer(C.free))
 }
+status := C.cairo_surface_set_mime_data(surface.Ptr, c_mimeType, (*C.uchar)(c_data), C.ulong(len(data)), destroy, c_data)
+if err := Status(status).toError(); err != nil {
+// cairo only calls destroy once it has taken the data.
+C.free(c_data)
+panic(err)
+}
+}`,
 
-var manualImpl = map[string]string{
-	"cairo_image_surface_get_data": `func (i *ImageSurface) Data() []byte {
-	buf := C.cairo_image_surface_get_data(i.Ptr)
-	return C.GoBytes(unsafe.Pointer(buf), C.int(i.GetStride()*i.GetHeight()))
+	"cairo_show_text_glyphs": `func (cr *Context) ShowTextGlyphs(utf8 string, glyphs []Glyph, clusters []TextCluster, clusterFlags TextClusterFlags) {
+if cr.Ptr == nil {
+panic(StatusNullPointer)
+}
+c_utf8 := C.CString(utf8)
+defer C.free(unsafe.Pointer(c_utf8))
+C.cairo_show_text_glyphs(cr.Ptr, c_utf8, C.int(len(utf8)), (*C.cairo_glyph_t)(sliceBytes(unsafe.Pointer(&glyphs))), C.int(len(glyphs)), (*C.cairo_text_cluster_t)(sliceBytes(unsafe.Pointer(&clusters))), C.int(len(clusters)), C.cairo_text_cluster_flags_t(clusterFlags))
+if err := cr.status(); err != nil {
+panic(err)
+}
+}`,
+
+	"cairo_scaled_font_text_to_glyphs": `func (scaledFont *ScaledFont) TextToGlyphs(x, y float64, utf8 string) ([]Glyph, []TextCluster, TextClusterFlags, error) {
+if scaledFont.Ptr == nil {
+panic(StatusNullPointer)
+}
+c_utf8 := C.CString(utf8)
+defer C.free(unsafe.Pointer(c_utf8))
+var cGlyphs *C.cairo_glyph_t
+var cNumGlyphs C.int
+var cClusters *C.cairo_text_cluster_t
+var cNumClusters C.int
+var cClusterFlags C.cairo_text_cluster_flags_t
+status := C.cairo_scaled_font_text_to_glyphs(scaledFont.Ptr, C.double(x), C.double(y), c_utf8, C.int(len(utf8)), &cGlyphs, &cNumGlyphs, &cClusters, &cNumClusters, &cClusterFlags)
+if err := Status(status).toError(); err != nil {
+return nil, nil, 0, err
+}
+defer C.cairo_glyph_free(cGlyphs)
+defer C.cairo_text_cluster_free(cClusters)
+glyphs := make([]Glyph, cNumGlyphs)
+if cNumGlyphs > 0 {
+slice := (*[1<<30]C.cairo_glyph_t)(unsafe.Pointer(cGlyphs))[:cNumGlyphs:cNumGlyphs]
+for i, g := range slice {
+glyphs[i] = Glyph{Index: uint32(g.index), X: float64(g.x), Y: float64(g.y)}
+}
+}
+clusters := make([]TextCluster, cNumClusters)
+if cNumClusters > 0 {
+copy(clusters, (*[1<<30]TextCluster)(unsafe.Pointer(cClusters))[:cNumClusters:cNumClusters])
+}
+return glyphs, clusters, TextClusterFlags(cClusterFlags), nil
+}`,
+
+	"cairo_pattern_get_surface": `func (pattern *SurfacePattern) Surface() (*Surface, error) {
+if pattern.Ptr == nil {
+panic(StatusNullPointer)
+}
+var surface *C.cairo_surface_t
+ret := Status(C.cairo_pattern_get_surface(pattern.Ptr, &surface)).toError()
+if err := pattern.status(); err != nil {
+panic(err)
+}
+if ret != nil {
+return nil, ret
+}
+// The pattern still owns surface, so take our own reference.
+return wrapSurface(C.cairo_surface_reference(surface)), nil
+}`,
+
+	"cairo_surface_map_to_image": `func (surface *Surface) MapToImage(rect *image.Rectangle) *MappedImage {
+if surface.Ptr == nil {
+panic(StatusNullPointer)
+}
+var extents *C.cairo_rectangle_int_t
+if rect != nil {
+r := RectangleIntFromImage(*rect)
+extents = (*C.cairo_rectangle_int_t)(unsafe.Pointer(&r))
+}
+p := C.cairo_surface_map_to_image(surface.Ptr, extents)
+// The image belongs to surface until UnmapImage, so it gets no
+// finalizer.
+ret := &MappedImage{&ImageSurface{&Surface{p}}, surface}
+if err := ret.status(); err != nil {
+C.cairo_surface_destroy(p)
+panic(err)
+}
+return ret
+}`,
+
+	"cairo_surface_unmap_image": `func (surface *Surface) UnmapImage(image *MappedImage) {
+if surface.Ptr == nil {
+panic(StatusNullPointer)
+}
+if image.Ptr == nil {
+panic(StatusNullPointer)
+}
+C.cairo_surface_unmap_image(surface.Ptr, image.Ptr)
+// cairo destroyed the image.
+image.Ptr = nil
+if err := surface.status(); err != nil {
+panic(err)
+}
+}`,
+
+	"cairo_copy_clip_rectangle_list": `func (cr *Context) ClipRectangles() ([]Rectangle, error) {
+if cr.Ptr == nil {
+panic(StatusNullPointer)
+}
+list := C.cairo_copy_clip_rectangle_list(cr.Ptr)
+defer C.cairo_rectangle_list_destroy(list)
+if err := cr.status(); err != nil {
+panic(err)
+}
+// This is StatusClipNotRepresentable if the clip isn't rectangles.
+if err := Status(list.status).toError(); err != nil {
+return nil, err
+}
+rects := make([]Rectangle, list.num_rectangles)
+if len(rects) > 0 {
+copy(rects, (*[1<<30]Rectangle)(unsafe.Pointer(list.rectangles))[:len(rects):len(rects)])
+}
+return rects, nil
+}`,
+
+	"cairo_image_surface_create_for_data": `func ImageSurfaceCreateForData(data unsafe.Pointer, format Format, width, height, stride int) *ImageSurface {
+ret := &ImageSurface{wrapSurface(C.cairo_image_surface_create_for_data((*C.uchar)(data), C.cairo_format_t(format), C.int(width), C.int(height), C.int(stride)))}
+if err := ret.status(); err != nil {
+panic(err)
+}
+return ret
+}`,
+
+	"cairo_ft_font_face_create_for_pattern": `func FTFontFaceCreateForPattern(pattern *FcPattern) *FTFontFace {
+if pattern.Ptr == nil {
+panic(StatusNullPointer)
+}
+ret := &FTFontFace{wrapFontFace(C.cairo_ft_font_face_create_for_pattern(pattern.Ptr))}
+if err := ret.status(); err != nil {
+panic(err)
+}
+return ret
+}`,
+
+	"cairo_ft_font_options_substitute": `func FTFontOptionsSubstitute(options *FontOptions, pattern *FcPattern) {
+if options.Ptr == nil {
+panic(StatusNullPointer)
+}
+if pattern.Ptr == nil {
+panic(StatusNullPointer)
+}
+C.cairo_ft_font_options_substitute(options.Ptr, pattern.Ptr)
+}`,
+
+	"cairo_tag_begin": `func (cr *Context) TagBegin(tagName string, attrs TagAttributes) {
+if cr.Ptr == nil {
+panic(StatusNullPointer)
+}
+attributes := ""
+if attrs != nil {
+attributes = attrs.String()
+}
+c_tagName := C.CString(tagName)
+defer C.free(unsafe.Pointer(c_tagName))
+c_attributes := C.CString(attributes)
+defer C.free(unsafe.Pointer(c_attributes))
+C.cairo_tag_begin(cr.Ptr, c_tagName, c_attributes)
+if err := cr.status(); err != nil {
+panic(err)
+}
+}`,
+
+	"cairo_surface_observer_print": `func (surface *SurfaceObserver) Print(w io.Writer) error {
+if surface.Ptr == nil {
+panic(StatusNullPointer)
+}
+key := goPointers.put(writeClosure{w: w})
+status := C.cairo_surface_observer_print(surface.Ptr,
+(C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(&key))
+goPointers.clear(key)
+return Status(status).toError()
+}`,
+	"cairo_device_observer_print": `func (device *Device) ObserverPrint(w io.Writer) error {
+if device.Ptr == nil {
+panic(StatusNullPointer)
+}
+key := goPointers.put(writeClosure{w: w})
+status := C.cairo_device_observer_print(device.Ptr,
+(C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(&key))
+goPointers.clear(key)
+return Status(status).toError()
+}`,
+	"cairo_script_create_for_stream": `func ScriptCreateForStream(w io.Writer) *ScriptDevice {
+key := newStreamKey(w)
+dev := C.cairo_script_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key))
+attachDeviceStreamKey(dev, key)
+ret := &ScriptDevice{wrapDevice(dev)}
+if err := ret.status(); err != nil {
+panic(err)
+}
+return ret
+}`,
+
+	"cairo_script_write_comment": `func (script *ScriptDevice) WriteComment(comment string) {
+if script.Ptr == nil {
+panic(StatusNullPointer)
+}
+c_comment := C.CString(comment)
+defer C.free(unsafe.Pointer(c_comment))
+C.cairo_script_write_comment(script.Ptr, c_comment, C.int(len(comment)))
+if err := script.status(); err != nil {
+panic(err)
+}
+}`,
+
+	"cairo_region_get_rectangle": `func (region *Region) GetRectangle(nth int) RectangleInt {
+if region.Ptr == nil {
+panic(StatusNullPointer)
+}
+// cairo doesn't check nth itself.
+if nth < 0 || nth >= region.NumRectangles() {
+panic(StatusInvalidIndex)
+}
+var rectangle RectangleInt
+C.cairo_region_get_rectangle(region.Ptr, C.int(nth), (*C.cairo_rectangle_int_t)(unsafe.Pointer(&rectangle)))
+if err := region.status(); err != nil {
+panic(err)
+}
+return rectangle
+}`,
+
+	"cairo_pdf_get_versions": `func PDFGetVersions() []PDFVersion {
+var cVersionsPtr *C.cairo_pdf_version_t
+var cNumVersions C.int
+C.cairo_pdf_get_versions(&cVersionsPtr, &cNumVersions)
+slice := (*[1<<30]C.cairo_pdf_version_t)(unsafe.Pointer(cVersionsPtr))[:cNumVersions:cNumVersions]
+versions := make([]PDFVersion, cNumVersions)
+for i := 0; i < int(cNumVersions); i++ {
+versions[i] = PDFVersion(slice[i])
+}
+return versions
+}`,
+
+	"cairo_pdf_surface_create_for_stream": `func PDFSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) *PDFSurface {
+key := newStreamKey(w)
+surf := C.cairo_pdf_surface_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key), C.double(widthInPoints), C.double(heightInPoints))
+attachStreamKey(surf, key)
+ret := &PDFSurface{wrapSurface(surf)}
+if err := ret.status(); err != nil {
+panic(err)
+}
+return ret
+}`,
+
+	"cairo_ps_surface_create_for_stream": `func PSSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) *PSSurface {
+key := newStreamKey(w)
+surf := C.cairo_ps_surface_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key), C.double(widthInPoints), C.double(heightInPoints))
+attachStreamKey(surf, key)
+ret := &PSSurface{wrapSurface(surf)}
+if err := ret.status(); err != nil {
+panic(err)
+}
+return ret
+}`,
+
+	"cairo_ps_get_levels": `func PSGetLevels() []PSLevel {
+var cLevelsPtr *C.cairo_ps_level_t
+var cNumLevels C.int
+C.cairo_ps_get_levels(&cLevelsPtr, &cNumLevels)
+slice := (*[1<<30]C.cairo_ps_level_t)(unsafe.Pointer(cLevelsPtr))[:cNumLevels:cNumLevels]
+levels := make([]PSLevel, cNumLevels)
+for i := 0; i < int(cNumLevels); i++ {
+levels[i] = PSLevel(slice[i])
+}
+return levels
+}`,
+
+	"cairo_svg_surface_create_for_stream": `func SVGSurfaceCreateForStream(w io.Writer, widthInPoints, heightInPoints float64) *SVGSurface {
+key := newStreamKey(w)
+surf := C.cairo_svg_surface_create_for_stream((C.cairo_write_func_t)(unsafe.Pointer(C.gocairo_write_func)), unsafe.Pointer(key), C.double(widthInPoints), C.double(heightInPoints))
+attachStreamKey(surf, key)
+ret := &SVGSurface{wrapSurface(surf)}
+if err := ret.status(); err != nil {
+panic(err)
+}
+return ret
 }`,
 
 	"cairo_svg_get_versions": `func SVGGetVersions() []SVGVersion {
@@ -108,6 +482,252 @@ return versions
 }`,
 }
 
+func init() {
+	// The surface observer callbacks only differ in the operation.
+	for _, op := range []string{"paint", "mask", "fill", "stroke", "glyphs", "flush", "finish"} {
+		manualImpl["cairo_surface_observer_add_"+op+"_callback"] = fmt.Sprintf(`func (surface *SurfaceObserver) Add%sCallback(f SurfaceObserverCallback) {
+if surface.Ptr == nil {
+panic(StatusNullPointer)
+}
+key := newObserverKey(surface.Ptr, f)
+status := C.cairo_surface_observer_add_%s_callback(surface.Ptr,
+(C.cairo_surface_observer_callback_t)(unsafe.Pointer(C.gocairo_surface_observer_callback)), unsafe.Pointer(key))
+if err := Status(status).toError(); err != nil {
+panic(err)
+}
+}`, cNameToGoUpper(op), op)
+	}
+}
+
+// borrowedReturns lists functions that return a pointer still owned by
+// their argument.  We take our own reference before wrapping the result,
+// so that its finalizer doesn't free the object out from under the owner.
+var borrowedReturns = map[string]bool{
+	"cairo_get_target":                true,
+	"cairo_get_group_target":          true,
+	"cairo_get_source":                true,
+	"cairo_get_font_face":             true,
+	"cairo_get_scaled_font":           true,
+	"cairo_surface_get_device":        true,
+	"cairo_scaled_font_get_font_face": true,
+	"cairo_tee_surface_index":         true,
+}
+
+// manualDocs maps C names in manualImpl to a paragraph added to the end
+// of their doc comment.
+var manualDocs = map[string]string{
+	"cairo_pattern_get_surface": `// Use Pattern.ToSurfacePattern to get at the surface of a pattern from
+// e.g. Context.GetSource.`,
+	"cairo_image_surface_create_for_data": `// data must be C memory, or Go memory pinned with runtime.Pinner, and
+// stay valid for as long as the surface lives.`,
+	"cairo_surface_map_to_image": `// The image must be released with UnmapImage or its Close method, not
+// with ImageSurface.Close.`,
+	"cairo_ft_font_face_create_for_pattern": `// Build the pattern from a fontconfig name with FcNameParse.  The face
+// keeps its own copy, so pattern can be closed afterwards.`,
+}
+
+// manualExtra maps C names to hand-written code emitted after the
+// generated binding, for features that need more than the C API offers.
+var manualExtra = map[string]string{
+	"cairo_recording_surface_get_extents": `// ReplayTo draws the recording onto target, transformed by m if it isn't
+// nil.
+func (surface *RecordingSurface) ReplayTo(target *Surface, m *Matrix) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	cr := Create(target)
+	defer cr.Close()
+	if m != nil {
+		cr.Transform(m)
+	}
+	cr.SetSourceSurface(surface.Surface, 0, 0)
+	cr.Paint()
+}`,
+	"cairo_pdf_surface_create_for_stream": `func init() {
+	vectorExportFormats["pdf"] = func(w io.Writer, widthInPoints, heightInPoints float64) *Surface {
+		return PDFSurfaceCreateForStream(w, widthInPoints, heightInPoints).Surface
+	}
+}`,
+	"cairo_ps_surface_create_for_stream": `func init() {
+	vectorExportFormats["ps"] = func(w io.Writer, widthInPoints, heightInPoints float64) *Surface {
+		return PSSurfaceCreateForStream(w, widthInPoints, heightInPoints).Surface
+	}
+}`,
+	"cairo_svg_surface_create_for_stream": `func init() {
+	vectorExportFormats["svg"] = func(w io.Writer, widthInPoints, heightInPoints float64) *Surface {
+		return SVGSurfaceCreateForStream(w, widthInPoints, heightInPoints).Surface
+	}
+}`,
+	"cairo_image_surface_get_data": `// DataView returns the pixels of the surface without copying them, after
+// flushing any pending drawing.  The slice aliases the surface's buffer,
+// so it is only valid until the surface is finished or closed, and
+// writes to it must be followed by MarkDirty.  It is nil if the surface
+// has no data, e.g. because it was finished.
+func (i *ImageSurface) DataView() []byte {
+	if i.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	i.Flush()
+	buf := C.cairo_image_surface_get_data(i.Ptr)
+	if buf == nil {
+		return nil
+	}
+	n := i.GetStride() * i.GetHeight()
+	return unsafe.Slice((*byte)(buf), n)
+}
+
+// UpdateData calls f with DataView to modify the pixels in place, then
+// marks the surface dirty so that cairo picks up the changes.
+func (i *ImageSurface) UpdateData(f func(data []byte)) {
+	if i.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	f(i.DataView())
+	i.MarkDirty()
+}`,
+
+	"cairo_surface_map_to_image": `// MappedImage is an image of part of another surface, from
+// Surface.MapToImage.  It belongs to that surface: release it with
+// UnmapImage or Close, which write any changes back, and never Close
+// the embedded ImageSurface, which would destroy the surface's own copy.
+type MappedImage struct {
+	*ImageSurface
+	// target is the surface the image maps.
+	target *Surface
+}
+
+// Close unmaps the image from the surface it maps, as UnmapImage does,
+// returning the surface's error if any.  It is safe to call Close more
+// than once.
+func (image *MappedImage) Close() (err error) {
+	if image.Ptr == nil {
+		return nil
+	}
+	defer catchStatus(&err)
+	image.target.UnmapImage(image)
+	return nil
+}`,
+
+	"cairo_surface_unmap_image": `// WithMappedImage maps rect of surface, or all of it if rect is nil, to
+// an image for f to read or modify, and unmaps it once f returns.  img
+// is only valid during the call.
+func (surface *Surface) WithMappedImage(rect *image.Rectangle, f func(img *MappedImage)) {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
+	img := surface.MapToImage(rect)
+	defer func() {
+		// f may have closed img itself.
+		if img.Ptr != nil {
+			surface.UnmapImage(img)
+		}
+	}()
+	f(img)
+}`,
+
+	"cairo_surface_supports_mime_type": `// MIME types for SetMIMEData, from the CAIRO_MIME_TYPE_* macros.
+const (
+	MIMETypeJPEG           = "image/jpeg"
+	MIMETypePNG            = "image/png"
+	MIMETypeJP2            = "image/jp2"
+	MIMETypeURI            = "text/x-uri"
+	MIMETypeUniqueID       = "application/x-cairo.uuid"
+	MIMETypeJBIG2          = "application/x-cairo.jbig2"
+	MIMETypeJBIG2Global    = "application/x-cairo.jbig2-global"
+	MIMETypeJBIG2GlobalID  = "application/x-cairo.jbig2-global-id"
+	MIMETypeCCITTFax       = "image/g3fax"
+	MIMETypeCCITTFaxParams = "application/x-cairo.ccitt.params"
+	MIMETypeEPS            = "application/postscript"
+	MIMETypeEPSParams      = "application/x-cairo.eps.params"
+)`,
+
+	"cairo_ft_font_face_create_for_pattern": `// FcPattern is a fontconfig pattern describing a font, for
+// FTFontFaceCreateForPattern and FTFontOptionsSubstitute.
+type FcPattern struct {
+	Ptr *C.FcPattern
+}
+
+// FcNameParse parses a fontconfig font name, such as "DejaVu Sans:bold"
+// or "Monospace-12", into a pattern.
+func FcNameParse(name string) (*FcPattern, error) {
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	p := C.FcNameParse((*C.FcChar8)(unsafe.Pointer(cName)))
+	if p == nil {
+		return nil, fmt.Errorf("cairo: can't parse font name %q", name)
+	}
+	pattern := &FcPattern{p}
+	runtime.SetFinalizer(pattern, (*FcPattern).Close)
+	return pattern, nil
+}
+
+// String returns the pattern as a fontconfig font name, e.g. to see what
+// FTFontOptionsSubstitute added to it.
+func (pattern *FcPattern) String() string {
+	if pattern.Ptr == nil {
+		return ""
+	}
+	cName := C.FcNameUnparse(pattern.Ptr)
+	defer C.free(unsafe.Pointer(cName))
+	return C.GoString((*C.char)(unsafe.Pointer(cName)))
+}
+
+// Close releases the C FcPattern* without waiting for the garbage
+// collector.  It is safe to call Close more than once.  The error result
+// is always nil and is only there to implement io.Closer.
+func (pattern *FcPattern) Close() error {
+	if pattern.Ptr != nil {
+		C.FcPatternDestroy(pattern.Ptr)
+		pattern.Ptr = nil
+	}
+	runtime.SetFinalizer(pattern, nil)
+	return nil
+}`,
+
+	"cairo_ft_font_face_create_for_ft_face": `// FTFontFaceCreateForFile loads the face at index within the font file
+// at path using FreeType.  loadFlags are FreeType's FT_LOAD_* flags, as
+// for FTFontFaceCreateForFTFace.
+func FTFontFaceCreateForFile(path string, index, loadFlags int) (*FTFontFace, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+	var ftErr C.FT_Error
+	p := C.gocairo_ft_font_face_create(cPath, nil, 0, C.long(index), C.int(loadFlags), &ftErr)
+	if p == nil {
+		return nil, fmt.Errorf("cairo: loading %q: FreeType error %d", path, ftErr)
+	}
+	ret := &FTFontFace{wrapFontFace(p)}
+	if err := ret.status(); err != nil {
+		ret.Close()
+		return nil, err
+	}
+	return ret, nil
+}
+
+// FTFontFaceCreateForData is like FTFontFaceCreateForFile, but loads the
+// font from memory, such as a file from an embed.FS.  data is copied.
+func FTFontFaceCreateForData(data []byte, index, loadFlags int) (*FTFontFace, error) {
+	var ftErr C.FT_Error
+	p := C.gocairo_ft_font_face_create(nil, C.CBytes(data), C.long(len(data)), C.long(index), C.int(loadFlags), &ftErr)
+	if p == nil {
+		return nil, fmt.Errorf("cairo: loading font: FreeType error %d", ftErr)
+	}
+	ret := &FTFontFace{wrapFontFace(p)}
+	if err := ret.status(); err != nil {
+		ret.Close()
+		return nil, err
+	}
+	return ret, nil
+}`,
+}
+
+// paramTypes overrides the Go type of parameters, for C APIs that pass
+// enums as plain integers.  The key "" names the return value.
+var paramTypes = map[string]map[string]string{
+	"cairo_ft_font_face_set_synthesize":   {"synth_flags": "FTSynthesize"},
+	"cairo_ft_font_face_unset_synthesize": {"synth_flags": "FTSynthesize"},
+	"cairo_ft_font_face_get_synthesize":   {"": "FTSynthesize"},
+}
+
 // outParams maps a function name to a per-parameter bool of whether it's
 // an output-only param.
 var outParams = map[string][]bool{
@@ -117,20 +737,29 @@ var outParams = map[string][]bool{
 	"cairo_stroke_extents":                {false, true, true, true, true},
 	"cairo_recording_surface_ink_extents": {false, true, true, true, true},
 
+	"cairo_region_get_extents": {false, true},
+
 	"cairo_get_current_point":               {false, true, true},
 	"cairo_surface_get_device_scale":        {false, true, true},
 	"cairo_surface_get_device_offset":       {false, true, true},
 	"cairo_surface_get_fallback_resolution": {false, true, true},
 
-	// TODO
-	// "cairo_pattern_get_rgba":            {false, true, true, true, true},
-	// "cairo_pattern_get_color_stop_rgba": {false, false, true, true, true, true, true},
-	// "cairo_pattern_get_color_stop_count": {false, true},
+	// These also return a status, which becomes an error result.
+	"cairo_pattern_get_rgba":                   {false, true, true, true, true},
+	"cairo_pattern_get_color_stop_rgba":        {false, false, true, true, true, true, true},
+	"cairo_pattern_get_color_stop_count":       {false, true},
+	"cairo_pattern_get_linear_points":          {false, true, true, true, true},
+	"cairo_pattern_get_radial_circles":         {false, true, true, true, true, true, true},
+	"cairo_mesh_pattern_get_patch_count":       {false, true},
+	"cairo_mesh_pattern_get_corner_color_rgba": {false, false, false, true, true, true, true},
+	"cairo_mesh_pattern_get_control_point":     {false, false, false, true, true},
 }
 
 var arrayParams = map[string]int{
 	"cairo_set_dash": 1,
 
+	"cairo_region_create_rectangles": 0,
+
 	"cairo_show_glyphs":               1,
 	"cairo_glyph_path":                1,
 	"cairo_glyph_extents":             1,
@@ -151,12 +780,95 @@ var subTypes = []struct {
 	{"RecordingSurface", "Surface"},
 	{"SurfaceObserver", "Surface"},
 	{"ToyFontFace", "FontFace"},
+	{"FTFontFace", "FontFace"},
+	{"FTScaledFont", "ScaledFont"},
+	{"UserFontFace", "FontFace"},
 	{"MeshPattern", "Pattern"},
-
+	{"RasterSourcePattern", "Pattern"},
+	{"LinearGradient", "Pattern"},
+	{"RadialGradient", "Pattern"},
+	{"SurfacePattern", "Pattern"},
+	{"SolidPattern", "Pattern"},
+
+	{"PDFSurface", "Surface"},
+	{"PSSurface", "Surface"},
 	{"SVGSurface", "Surface"},
 
 	{"XlibSurface", "Surface"},
 	{"XlibDevice", "Device"},
+
+	{"ScriptSurface", "Surface"},
+	{"ScriptDevice", "Device"},
+
+	{"TeeSurface", "Surface"},
+}
+
+// subTypeKinds maps subtypes to the type enum value that identifies
+// them, for generating checked conversions from the super type such as
+// Pattern.ToSurfacePattern.
+var subTypeKinds = map[string]string{
+	"MeshPattern":         "PatternTypeMesh",
+	"RasterSourcePattern": "PatternTypeRasterSource",
+	"LinearGradient":      "PatternTypeLinear",
+	"RadialGradient":      "PatternTypeRadial",
+	"SurfacePattern":      "PatternTypeSurface",
+	"SolidPattern":        "PatternTypeSolid",
+	"FTFontFace":          "FontTypeFt",
+	"FTScaledFont":        "FontTypeFt",
+}
+
+// typeMismatch is the status for converting a super type to the wrong
+// subtype.
+var typeMismatch = map[string]string{
+	"Pattern":    "StatusPatternTypeMismatch",
+	"Surface":    "StatusSurfaceTypeMismatch",
+	"FontFace":   "StatusFontTypeMismatch",
+	"ScaledFont": "StatusFontTypeMismatch",
+	"Device":     "StatusDeviceTypeMismatch",
+}
+
+// subTypeReturns maps constructors to the subtype they return, where
+// it doesn't follow from the name as in ImageSurfaceCreate.
+var subTypeReturns = map[string]string{
+	"cairo_surface_create_observer":      "SurfaceObserver",
+	"cairo_surface_create_similar_image": "ImageSurface",
+	"cairo_pattern_create_rgb":           "SolidPattern",
+	"cairo_pattern_create_rgba":          "SolidPattern",
+	"cairo_pattern_create_for_surface":   "SurfacePattern",
+	"cairo_pattern_create_linear":        "LinearGradient",
+	"cairo_pattern_create_radial":        "RadialGradient",
+	"cairo_pattern_create_mesh":          "MeshPattern",
+	"cairo_script_create":                "ScriptDevice",
+}
+
+// subTypeMethods makes functions methods of subtypes where that doesn't
+// follow from their names, as in ScriptDevice.SetMode.  The values are
+// pairs of subtype and method name, generating a method for each.
+var subTypeMethods = map[string][][2]string{
+	"cairo_script_set_mode":               {{"ScriptDevice", "SetMode"}},
+	"cairo_script_get_mode":               {{"ScriptDevice", "GetMode"}},
+	"cairo_script_from_recording_surface": {{"ScriptDevice", "FromRecordingSurface"}},
+
+	"cairo_pattern_get_rgba":           {{"SolidPattern", "GetRGBA"}},
+	"cairo_pattern_get_linear_points":  {{"LinearGradient", "GetLinearPoints"}},
+	"cairo_pattern_get_radial_circles": {{"RadialGradient", "GetRadialCircles"}},
+	"cairo_pattern_get_color_stop_rgba": {
+		{"LinearGradient", "GetColorStopRGBA"},
+		{"RadialGradient", "GetColorStopRGBA"},
+	},
+	"cairo_pattern_get_color_stop_count": {
+		{"LinearGradient", "GetColorStopCount"},
+		{"RadialGradient", "GetColorStopCount"},
+	},
+}
+
+// valueMethodTypes are non-pointer Go types that get methods, such as
+// Format.StrideForWidth.  Methods on these never check a status.
+var valueMethodTypes = map[string]bool{
+	"Format":     true,
+	"PDFVersion": true,
+	"PSLevel":    true,
+	"SVGVersion": true,
 }
 
 var rawCTypes = map[string]bool{
@@ -167,14 +879,26 @@ var rawCTypes = map[string]bool{
 	"Screen":   true,
 }
 
+// stableNames pins the Go names of constants that were released before
+// one of their parts joined acronyms, so that adding an acronym doesn't
+// rename existing API.
+var stableNames = map[string]string{
+	"CAIRO_FONT_TYPE_FT":               "FontTypeFt",
+	"CAIRO_STATUS_INVALID_DSC_COMMENT": "StatusInvalidDscComment",
+}
+
 // acronyms are substrings that should be all caps or all lowercase.
 var acronyms = map[string]bool{
 	"argb":   true,
 	"argb32": true,
+	"ascii":  true,
 	"bgr":    true,
 	"cogl":   true,
 	"ctm":    true,
 	"drm":    true,
+	"ft":     true,
+	"dsc":    true,
+	"eps":    true,
 	"gl":     true,
 	"os2":    true,
 	"pdf":    true,
@@ -197,6 +921,9 @@ var acronyms = map[string]bool{
 type Writer struct {
 	bytes.Buffer
 	links map[string]string
+	// features are the pkg-config names of the optional parts of cairo
+	// we're generating bindings for.
+	features []string
 }
 
 func (w *Writer) Print(format string, a ...interface{}) {
@@ -262,6 +989,29 @@ type typeMap struct {
 	cToGo  func(in string) string
 	goToC  func(in string) (string, string)
 	method string
+	// cRef is the C function that adds a reference to the object, for
+	// wrapped pointer types.
+	cRef string
+	// wrapped is true for pointers to our structs holding a C Ptr.
+	wrapped bool
+}
+
+// withGoType returns a copy of m that uses the Go type goType, which must
+// convert to and from the same C type.
+func withGoType(m *typeMap, goType string) *typeMap {
+	ret := *m
+	ret.goType = goType
+	ret.cToGo = func(in string) string {
+		return fmt.Sprintf("%s(%s)", goType, in)
+	}
+	return &ret
+}
+
+// cObjectFunc returns the name of the C function that performs op on
+// the opaque type cType, e.g. cairo_surface_t + destroy gives
+// cairo_surface_destroy.
+func cObjectFunc(cType, op string) string {
+	return strings.TrimSuffix(cType, "_t") + "_" + op
 }
 
 func cTypeToMap(typ *cc.Type) *typeMap {
@@ -324,7 +1074,9 @@ func cTypeToMap(typ *cc.Type) *typeMap {
 			goToC: func(in string) (string, string) {
 				return fmt.Sprintf("%s.Ptr", in), ""
 			},
-			method: goName,
+			method:  goName,
+			cRef:    cObjectFunc(str, "reference"),
+			wrapped: true,
 		}
 	case cc.Void:
 		return &typeMap{
@@ -349,7 +1101,7 @@ func cTypeToMap(typ *cc.Type) *typeMap {
 				return fmt.Sprintf("%s != 0", in)
 			},
 			goToC: func(in string) (string, string) {
-				return fmt.Sprintf("C.%s(%s)", cName, in), ""
+				return fmt.Sprintf("C.%s(cBool(%s))", cName, in), ""
 			},
 		}
 	case "cairo_status_t":
@@ -360,6 +1112,16 @@ func cTypeToMap(typ *cc.Type) *typeMap {
 			},
 			goToC: nil,
 		}
+	case "FT_Face":
+		return &typeMap{
+			goType: "unsafe.Pointer",
+			cToGo: func(in string) string {
+				return fmt.Sprintf("unsafe.Pointer(%s)", in)
+			},
+			goToC: func(in string) (string, string) {
+				return fmt.Sprintf("C.%s(%s)", cName, in), ""
+			},
+		}
 	case "Drawable", "Pixmap":
 		return &typeMap{
 			goType: "uint64",
@@ -382,11 +1144,8 @@ func cTypeToMap(typ *cc.Type) *typeMap {
 			return fmt.Sprintf("C.%s(%s)", cName, in), ""
 		},
 	}
-	if goName == "Format" {
-		// Attempt to put methods on our "Format" type.
-		m.method = goName
-	}
-	if goName == "SVGVersion" {
+	if valueMethodTypes[goName] {
+		// Attempt to put methods on e.g. our "Format" type.
 		m.method = goName
 	}
 	return m
@@ -412,15 +1171,21 @@ func (w *Writer) genTypeDef(d *cc.Decl) {
 Ptr *C.%s
 }`, goName, d.Name)
 
-			cFinalizer := d.Name
-			if strings.HasSuffix(cFinalizer, "_t") {
-				cFinalizer = cFinalizer[:len(cFinalizer)-2]
-			}
-			cFinalizer += "_destroy"
+			cFinalizer := cObjectFunc(d.Name, "destroy")
 			w.Print("func free%s(obj *%s) {", goName, goName)
 			w.Print("C.%s(obj.Ptr)", cFinalizer)
 			w.Print("}")
 
+			w.Print("// Close releases the C %s* without waiting for the garbage collector.  Later method calls, and calls passing it as an argument, panic with StatusNullPointer.  It is safe to call Close more than once.  The error result is always nil and is only there to implement io.Closer.  Don't Close objects from Borrow%s.", d.Name, goName)
+			w.Print("func (obj *%s) Close() error {", goName)
+			w.Print("if obj.Ptr != nil {")
+			w.Print("C.%s(obj.Ptr)", cFinalizer)
+			w.Print("obj.Ptr = nil")
+			w.Print("runtime.SetFinalizer(obj, nil)")
+			w.Print("}")
+			w.Print("return nil")
+			w.Print("}")
+
 			w.Print("func wrap%s(p *C.%s) *%s {", goName, d.Name, goName)
 			w.Print("ret := &%s{p}", goName)
 			w.Print("runtime.SetFinalizer(ret, free%s)", goName)
@@ -441,7 +1206,13 @@ Ptr *C.%s
 			w.Print("type %s struct {", goName)
 			for _, d := range d.Type.Decls {
 				typ := cTypeToMap(d.Type)
-				w.Print("%s %s", cNameToGoUpper(d.Name), typ.goType)
+				goType := typ.goType
+				if goType == "int" {
+					// Pointers to these structs are cast across to C,
+					// so fields must match the C layout.
+					goType = "int32"
+				}
+				w.Print("%s %s", cNameToGoUpper(d.Name), goType)
 			}
 			w.Print("}")
 		}
@@ -456,6 +1227,9 @@ Ptr *C.%s
 				constName = constName[len("CAIRO_"):]
 			}
 			constName = cNameToGoUpper(strings.ToLower(d.Name))
+			if name, ok := stableNames[d.Name]; ok {
+				constName = name
+			}
 			consts = append(consts, constEntry{constName, d.Name})
 		}
 
@@ -498,14 +1272,39 @@ func shouldBeMethod(goName string, goType string) (string, string) {
 }
 
 func (w *Writer) genFunc(f *cc.Decl) bool {
+	methods := subTypeMethods[f.Name]
+	if methods == nil {
+		return w.genFuncAs(f, nil)
+	}
+	for i := range methods {
+		if i > 0 {
+			w.Print("")
+		}
+		if !w.genFuncAs(f, &methods[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// genFuncAs generates f, as the method of a subtype given by method if
+// it isn't nil.
+func (w *Writer) genFuncAs(f *cc.Decl, method *[2]string) bool {
 	name := cNameToGoUpper(f.Name)
 
 	retType := cTypeToMap(f.Type.Base)
 	if retType == nil {
 		return false
 	}
+	if goType, ok := paramTypes[f.Name][""]; ok {
+		retType = withGoType(retType, goType)
+	}
 	var retTypeSigs []string
 	var retVals []string
+	if borrowedReturns[f.Name] && retType.cRef == "" {
+		panic(f.Name + ": borrowed return of non-refcounted type")
+	}
+	cRef := retType.cRef
 	if f.Type.Base.Kind == cc.Void {
 		retType = nil
 	} else {
@@ -515,8 +1314,7 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 		// (e.g. ImageSurfaceCreate), adjust the return type code.
 		for _, t := range subTypes {
 			if retType.goType == "*"+t.super &&
-				(strings.HasPrefix(name, t.sub) ||
-					(name == "SurfaceCreateObserver" && t.sub == "SurfaceObserver")) {
+				(strings.HasPrefix(name, t.sub) || subTypeReturns[f.Name] == t.sub) {
 				goType = "*" + t.sub
 				inner := retType
 				retType = &typeMap{
@@ -532,12 +1330,18 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 	}
 
 	outs := outParams[f.Name]
+	statusOut := false
 	if outs != nil {
 		if len(outs) != len(f.Type.Decls) {
 			panic("outParams mismatch for " + f.Name)
 		}
 		if retTypeSigs != nil {
-			panic(f.Name + ": outParams and return type")
+			if retType.goType != "error" {
+				panic(f.Name + ": outParams and return type")
+			}
+			// Return the status as an error after the out params.
+			statusOut = true
+			retTypeSigs = nil
 		}
 	}
 	arrayParam := -1
@@ -550,6 +1354,7 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 	var callArgs []string
 	var getErrorCall string
 	var methodSig string
+	var nilChecks []string
 	var preCall string
 
 	for i := 0; i < len(f.Type.Decls); i++ {
@@ -566,8 +1371,14 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 		if argType == nil {
 			return false
 		}
+		if goType, ok := paramTypes[f.Name][d.Name]; ok {
+			argType = withGoType(argType, goType)
+		}
 
 		methName, methType := shouldBeMethod(name, argType.method)
+		if method != nil {
+			methName, methType = method[1], "*"+method[0]
+		}
 		if i == 0 && methName != "" {
 			name = methName
 			if name == "Status" {
@@ -577,7 +1388,11 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 				methType = argType.goType
 			}
 			methodSig = fmt.Sprintf("(%s %s)", argName, methType)
-			if name != "status" && methType != "Format" && methType != "SVGVersion" && methType != "*Matrix" {
+			if name != "status" && argType.wrapped {
+				// Guard against use after Close.
+				nilChecks = append(nilChecks, fmt.Sprintf("if %s.Ptr == nil {\npanic(StatusNullPointer)\n}", argName))
+			}
+			if name != "status" && !valueMethodTypes[methType] && methType != "*Matrix" {
 				getErrorCall = fmt.Sprintf("%s.status()", argName)
 			}
 		} else if outParam {
@@ -594,7 +1409,19 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 					return "&" + in, ""
 				},
 			}
-			preCall += fmt.Sprintf("var %s C.%s\n", argName, d.Type.Base)
+			if goType, ok := sharedTypes[d.Type.Base.String()]; ok {
+				// Structs are filled in through a cast pointer.
+				cType := d.Type.Base.String()
+				argType.cToGo = func(in string) string {
+					return in
+				}
+				argType.goToC = func(in string) (string, string) {
+					return fmt.Sprintf("(*C.%s)(unsafe.Pointer(&%s))", cType, in), ""
+				}
+				preCall += fmt.Sprintf("var %s %s\n", argName, goType)
+			} else {
+				preCall += fmt.Sprintf("var %s C.%s\n", argName, d.Type.Base)
+			}
 			retTypeSigs = append(retTypeSigs, fmt.Sprintf(argType.goType))
 			retVals = append(retVals, argType.cToGo(cNameToGoLower(d.Name)))
 		} else if i == arrayParam {
@@ -608,6 +1435,10 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 		} else {
 			inArgs = append(inArgs, argName)
 			inArgTypes = append(inArgTypes, argType.goType)
+			if argType.wrapped {
+				// A closed argument would pass NULL to cairo.
+				nilChecks = append(nilChecks, fmt.Sprintf("if %s.Ptr == nil {\npanic(StatusNullPointer)\n}", argName))
+			}
 		}
 		if argType.goToC == nil {
 			panic("in " + name + " need goToC for " + argName)
@@ -628,6 +1459,9 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 		}
 	}
 
+	if statusOut {
+		retTypeSigs = append(retTypeSigs, "error")
+	}
 	retTypeSig := strings.Join(retTypeSigs, ", ")
 	if len(retTypeSigs) > 1 {
 		retTypeSig = "(" + retTypeSig + ")"
@@ -635,10 +1469,16 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 
 	w.writeDocString(f.Name, "()")
 	w.Print("func %s %s(%s) %s {", methodSig, name, argSig, retTypeSig)
+	for _, check := range nilChecks {
+		w.Print("%s", check)
+	}
 	if preCall != "" {
 		w.Print("%s", preCall)
 	}
 	call := fmt.Sprintf("C.%s(%s)", f.Name, strings.Join(callArgs, ", "))
+	if borrowedReturns[f.Name] {
+		call = fmt.Sprintf("C.%s(%s)", cRef, call)
+	}
 
 	if retType != nil {
 		w.Print("ret := %s", retType.cToGo(call))
@@ -653,6 +1493,9 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 		w.Print("if err := %s; err != nil { panic(err) }", getErrorCall)
 	}
 
+	if statusOut {
+		retVals = append(retVals, "ret")
+	}
 	if retTypeSigs != nil {
 		if retVals != nil {
 			w.Print("return %s", strings.Join(retVals, ", "))
@@ -665,6 +1508,7 @@ func (w *Writer) genFunc(f *cc.Decl) bool {
 }
 
 func (w *Writer) process(decls []*cc.Decl) {
+	pkgConfig := strings.Join(append([]string{"cairo"}, w.features...), " ")
 	w.Print(`// Copyright 2015 Google Inc. All Rights Reserved.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -685,24 +1529,67 @@ package cairo
 
 import (
 	"fmt"
+	"image"
 	"io"
 	"runtime"
 	"unsafe"
 )
 
 /*
-#cgo pkg-config: cairo
+#cgo pkg-config: %s
 #include <cairo.h>
+#if CAIRO_HAS_FT_FONT
+#include <cairo-ft.h>
+#endif
+#if CAIRO_HAS_PDF_SURFACE
+#include <cairo-pdf.h>
+#endif
+#if CAIRO_HAS_PS_SURFACE
+#include <cairo-ps.h>
+#endif
 #if CAIRO_HAS_SVG_SURFACE
 #include <cairo-svg.h>
 #endif
 #if CAIRO_HAS_XLIB_SURFACE
 #include <cairo-xlib.h>
 #endif
+#if CAIRO_HAS_SCRIPT_SURFACE
+#include <cairo-script.h>
+#endif
+#if CAIRO_HAS_TEE_SURFACE
+#include <cairo-tee.h>
+#endif
 #include <stdlib.h>
 
 int gocairoWriteFunc(int key, const unsigned char* data, unsigned int length);
 int gocairoReadFunc(int key, const unsigned char* data, unsigned int length);
+void gocairoFreeKey(int key);
+cairo_status_t gocairoUserFontInit(int key, cairo_scaled_font_t *scaled_font,
+                                   cairo_t *cr, cairo_font_extents_t *extents);
+cairo_status_t gocairoUserFontRenderGlyph(int key,
+                                          cairo_scaled_font_t *scaled_font,
+                                          unsigned long glyph, cairo_t *cr,
+                                          cairo_text_extents_t *extents);
+cairo_status_t gocairoUserFontTextToGlyphs(int key,
+                                           cairo_scaled_font_t *scaled_font,
+                                           char *utf8, int utf8_len,
+                                           cairo_glyph_t **glyphs,
+                                           int *num_glyphs,
+                                           cairo_text_cluster_t **clusters,
+                                           int *num_clusters,
+                                           cairo_text_cluster_flags_t *cluster_flags);
+cairo_status_t gocairoUserFontUnicodeToGlyph(int key,
+                                             cairo_scaled_font_t *scaled_font,
+                                             unsigned long unicode,
+                                             unsigned long *glyph_index);
+cairo_surface_t *gocairoRasterSourceAcquire(int key, cairo_surface_t *target,
+                                            cairo_rectangle_int_t *extents);
+void gocairoRasterSourceRelease(int key, cairo_surface_t *surface);
+cairo_status_t gocairoRasterSourceSnapshot(int key);
+cairo_status_t gocairoRasterSourceCopy(int key);
+int gocairoRasterSourceFinish(int key);
+void gocairoSurfaceObserverCallback(int key, cairo_surface_t *observer,
+                                    cairo_surface_t *target);
 
 // A cairo_write_func_t for use in cairo_surface_write_to_png.
 cairo_status_t gocairo_write_func(void *closure,
@@ -721,6 +1608,160 @@ cairo_status_t gocairo_read_func(void *closure,
     ? CAIRO_STATUS_SUCCESS
     : CAIRO_STATUS_WRITE_ERROR;
 }
+
+// A cairo_destroy_func_t that releases a closure from newKey.
+void gocairo_free_key(void *closure) {
+  gocairoFreeKey(*(int*)closure);
+  free(closure);
+}
+
+// Key for the user data that holds the closure of a stream surface.
+static cairo_user_data_key_t gocairo_stream_key;
+
+// Key for the user data that holds the UserFont of a user font face.
+static cairo_user_data_key_t gocairo_user_font_key;
+
+// Returns the key of the UserFont behind scaled_font.
+static int gocairo_user_font(cairo_scaled_font_t *scaled_font) {
+  cairo_font_face_t *font_face = cairo_scaled_font_get_font_face(scaled_font);
+  return *(int*)cairo_font_face_get_user_data(font_face,
+                                              &gocairo_user_font_key);
+}
+
+// The callbacks of a user font face, which forward to its UserFont.
+cairo_status_t gocairo_user_font_init(cairo_scaled_font_t *scaled_font,
+                                      cairo_t *cr,
+                                      cairo_font_extents_t *extents) {
+  return gocairoUserFontInit(gocairo_user_font(scaled_font),
+                             scaled_font, cr, extents);
+}
+
+cairo_status_t gocairo_user_font_render_glyph(cairo_scaled_font_t *scaled_font,
+                                              unsigned long glyph,
+                                              cairo_t *cr,
+                                              cairo_text_extents_t *extents) {
+  return gocairoUserFontRenderGlyph(gocairo_user_font(scaled_font),
+                                    scaled_font, glyph, cr, extents);
+}
+
+cairo_status_t gocairo_user_font_text_to_glyphs(cairo_scaled_font_t *scaled_font,
+                                                const char *utf8, int utf8_len,
+                                                cairo_glyph_t **glyphs,
+                                                int *num_glyphs,
+                                                cairo_text_cluster_t **clusters,
+                                                int *num_clusters,
+                                                cairo_text_cluster_flags_t *cluster_flags) {
+  return gocairoUserFontTextToGlyphs(gocairo_user_font(scaled_font),
+                                     scaled_font, (char*)utf8, utf8_len,
+                                     glyphs, num_glyphs,
+                                     clusters, num_clusters, cluster_flags);
+}
+
+cairo_status_t gocairo_user_font_unicode_to_glyph(cairo_scaled_font_t *scaled_font,
+                                                  unsigned long unicode,
+                                                  unsigned long *glyph_index) {
+  return gocairoUserFontUnicodeToGlyph(gocairo_user_font(scaled_font),
+                                       scaled_font, unicode, glyph_index);
+}
+
+// The callbacks of a raster source pattern, which forward to its
+// RasterSource.  The callback data is a key from newKey, shared by the
+// pattern and its copies.
+cairo_surface_t *gocairo_raster_source_acquire(cairo_pattern_t *pattern,
+                                               void *callback_data,
+                                               cairo_surface_t *target,
+                                               const cairo_rectangle_int_t *extents) {
+  return gocairoRasterSourceAcquire(*(int*)callback_data, target,
+                                    (cairo_rectangle_int_t*)extents);
+}
+
+void gocairo_raster_source_release(cairo_pattern_t *pattern,
+                                   void *callback_data,
+                                   cairo_surface_t *surface) {
+  if (surface) {
+    gocairoRasterSourceRelease(*(int*)callback_data, surface);
+    // Drop the reference taken in gocairoRasterSourceAcquire.
+    cairo_surface_destroy(surface);
+  }
+}
+
+cairo_status_t gocairo_raster_source_snapshot(cairo_pattern_t *pattern,
+                                              void *callback_data) {
+  return gocairoRasterSourceSnapshot(*(int*)callback_data);
+}
+
+cairo_status_t gocairo_raster_source_copy(cairo_pattern_t *pattern,
+                                          void *callback_data,
+                                          const cairo_pattern_t *other) {
+  return gocairoRasterSourceCopy(*(int*)callback_data);
+}
+
+void gocairo_raster_source_finish(cairo_pattern_t *pattern,
+                                  void *callback_data) {
+  // Each copy of the pattern gets finished, and the last one frees
+  // the key.
+  if (gocairoRasterSourceFinish(*(int*)callback_data))
+    free(callback_data);
+}
+
+// A cairo_surface_observer_callback_t that forwards to the
+// SurfaceObserverCallback behind data, a key from newObserverKey.
+void gocairo_surface_observer_callback(cairo_surface_t *observer,
+                                       cairo_surface_t *target,
+                                       void *data) {
+  gocairoSurfaceObserverCallback(*(int*)data, observer, target);
+}
+
+#if CAIRO_HAS_FT_FONT
+// Key for the user data that holds the FreeType face of a font face.
+static cairo_user_data_key_t gocairo_ft_key;
+
+// A FreeType face that cairo owns.  Each face gets its own library so
+// that cairo can free it from any thread.
+typedef struct {
+  FT_Library library;
+  void *data;
+} gocairo_ft_face;
+
+void gocairo_ft_face_destroy(void *closure) {
+  gocairo_ft_face *f = closure;
+  if (f->library)
+    FT_Done_FreeType(f->library);
+  free(f->data);
+  free(f);
+}
+
+// Creates a font face for the font in the file at path, or if path is
+// NULL, in the size bytes at data, which the font face takes ownership
+// of.  Returns NULL and sets *ft_err if FreeType can't load the font.
+cairo_font_face_t *gocairo_ft_font_face_create(const char *path,
+                                               void *data, long size,
+                                               long index, int load_flags,
+                                               FT_Error *ft_err) {
+  gocairo_ft_face *f = calloc(1, sizeof(*f));
+  FT_Face face;
+  f->data = data;
+  *ft_err = FT_Init_FreeType(&f->library);
+  if (!*ft_err) {
+    *ft_err = path
+      ? FT_New_Face(f->library, path, index, &face)
+      : FT_New_Memory_Face(f->library, data, size, index, &face);
+  }
+  if (*ft_err) {
+    gocairo_ft_face_destroy(f);
+    return NULL;
+  }
+  cairo_font_face_t *font_face =
+    cairo_ft_font_face_create_for_ft_face(face, load_flags);
+  if (cairo_font_face_set_user_data(font_face, &gocairo_ft_key, f,
+                                    gocairo_ft_face_destroy)) {
+    // The font face is in an error state, so it's safe to free the
+    // FreeType face out from under it.
+    gocairo_ft_face_destroy(f);
+  }
+  return font_face;
+}
+#endif
 */
 import "C"
 
@@ -731,6 +1772,9 @@ func (s Status) Error() string {
 
 // WriteToPNG encodes a Surface to an io.Writer as a PNG file.
 func (surface *Surface) WriteToPNG(w io.Writer) error {
+	if surface.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	data := writeClosure{w: w}
 	key := goPointers.put(data)
 	status := C.cairo_surface_write_to_png_stream((*C.cairo_surface_t)(surface.Ptr),
@@ -742,6 +1786,57 @@ func (surface *Surface) WriteToPNG(w io.Writer) error {
 	return Status(status).toError()
 }
 
+// newKey stashes data in goPointers for as long as some cairo object
+// lives.  Unlike in WriteToPNG, cairo holds on to the closure after the
+// call returns, so the key must live in C memory.  Pass the key to
+// gocairo_free_key once cairo is done with it.
+func newKey(data interface{}) *C.int {
+	key := (*C.int)(C.malloc(C.size_t(unsafe.Sizeof(C.int(0)))))
+	*key = goPointers.put(data)
+	return key
+}
+
+// newStreamKey stashes w for use by gocairo_write_func for as long as a
+// stream surface lives.
+func newStreamKey(w io.Writer) *C.int {
+	return newKey(writeClosure{w: w})
+}
+
+// attachStreamKey arranges for a key from newStreamKey to be released
+// once cairo destroys surface.
+func attachStreamKey(surface *C.cairo_surface_t, key *C.int) {
+	status := C.cairo_surface_set_user_data(surface, &C.gocairo_stream_key,
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		// Error surfaces don't hold user data, so free it now.
+		C.gocairo_free_key(unsafe.Pointer(key))
+	}
+}
+
+// attachDeviceStreamKey is attachStreamKey for stream devices.
+func attachDeviceStreamKey(device *C.cairo_device_t, key *C.int) {
+	status := C.cairo_device_set_user_data(device, &C.gocairo_stream_key,
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		C.gocairo_free_key(unsafe.Pointer(key))
+	}
+}
+
+// newObserverKey stashes f for gocairo_surface_observer_callback for as
+// long as observer lives.
+func newObserverKey(observer *C.cairo_surface_t, f SurfaceObserverCallback) *C.int {
+	key := newKey(f)
+	// Each key is unique, so it doubles as the user data key.
+	status := C.cairo_surface_set_user_data(observer, (*C.cairo_user_data_key_t)(unsafe.Pointer(key)),
+		unsafe.Pointer(key), (C.cairo_destroy_func_t)(unsafe.Pointer(C.gocairo_free_key)))
+	if status != C.CAIRO_STATUS_SUCCESS {
+		// observer is an error surface, so the callback never runs.
+		C.gocairo_free_key(unsafe.Pointer(key))
+		return nil
+	}
+	return key
+}
+
 // ImageSurfaceCreateFromPNGStream creates an ImageSurface from a stream of
 // PNG data.
 func ImageSurfaceCreateFromPNGStream(r io.Reader) (*ImageSurface, error) {
@@ -758,6 +1853,9 @@ func ImageSurfaceCreateFromPNGStream(r io.Reader) (*ImageSurface, error) {
 
 // PathIter creates an iterator over the segments within the path.
 func (p *Path) Iter() *PathIter {
+	if p.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	return &PathIter{path: p, i: 0}
 }
 
@@ -769,6 +1867,10 @@ type PathIter struct {
 
 // Next returns the next PathSegment, or returns nil at the end of the path.
 func (pi *PathIter) Next() *PathSegment {
+	// The path may have been closed since Iter.
+	if pi.path.Ptr == nil {
+		panic(StatusNullPointer)
+	}
 	if pi.i >= pi.path.Ptr.num_data {
 		return nil
 	}
@@ -779,11 +1881,21 @@ func (pi *PathIter) Next() *PathSegment {
 	pi.i += C.int(ofs)
 	return seg
 }
-`)
+`, pkgConfig)
 	for _, t := range subTypes {
 		w.Print(`type %s struct {
 *%s
 }`, t.sub, t.super)
+		if kind, ok := subTypeKinds[t.sub]; ok {
+			recv := strings.ToLower(t.super[:1]) + t.super[1:]
+			w.Print("")
+			w.Print("// To%s returns %s as a *%s, or fails with %s if it is another type of %s.", t.sub, recv, t.sub, typeMismatch[t.super], t.super)
+			w.Print("func (%s *%s) To%s() (*%s, error) {", recv, t.super, t.sub, t.sub)
+			w.Print("if %s.Ptr == nil {\npanic(StatusNullPointer)\n}", recv)
+			w.Print("if %s.GetType() != %s {\nreturn nil, %s\n}", recv, kind, typeMismatch[t.super])
+			w.Print("return &%s{%s}, nil", t.sub, recv)
+			w.Print("}")
+		}
 	}
 
 	intentionalSkips := 0
@@ -807,11 +1919,12 @@ func (pi *PathIter) Next() *PathSegment {
 			continue
 		}
 
-		if strings.HasSuffix(d.Name, "_func") ||
+		_, manual := manualImpl[d.Name]
+		if !manual && (strings.HasSuffix(d.Name, "_func") ||
 			strings.HasSuffix(d.Name, "_func_t") ||
 			strings.HasSuffix(d.Name, "_callback") ||
 			strings.HasSuffix(d.Name, "_callback_data") ||
-			strings.HasSuffix(d.Name, "_callback_t") {
+			strings.HasSuffix(d.Name, "_callback_t")) {
 			log.Printf("TODO %s: callbacks back into Go", d.Name)
 			todoSkips++
 			continue
@@ -836,6 +1949,10 @@ func (pi *PathIter) Next() *PathSegment {
 
 		if impl, ok := manualImpl[d.Name]; ok {
 			w.writeDocString(d.Name, "()")
+			if doc, ok := manualDocs[d.Name]; ok {
+				w.Print("//")
+				w.Print("%s", doc)
+			}
 			w.Print("%s", impl)
 		} else if d.Storage == cc.Typedef {
 			w.genTypeDef(d)
@@ -849,11 +1966,413 @@ func (pi *PathIter) Next() *PathSegment {
 			log.Printf("type kind %s", d.Type.Kind)
 			log.Printf("storage %s", d.Storage)
 		}
+		if extra, ok := manualExtra[d.Name]; ok {
+			w.Print("")
+			w.Print("%s", extra)
+		}
 		w.Print("")
 	}
 	log.Printf("%d decls total, %d skipped intentionally / %d TODO", len(decls), intentionalSkips, todoSkips)
 }
 
+// qualify renders a type expression from the cairo package as it must
+// be written from another package, noting any imports it needs.
+func qualify(expr ast.Expr, imports map[string]bool) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return "cairo." + e.Name
+		}
+		return e.Name
+	case *ast.StarExpr:
+		return "*" + qualify(e.X, imports)
+	case *ast.ArrayType:
+		return "[]" + qualify(e.Elt, imports)
+	case *ast.SelectorExpr:
+		pkg := e.X.(*ast.Ident).Name
+		imports[pkg] = true
+		return pkg + "." + e.Sel.Name
+	case *ast.FuncType:
+		sig := "func(" + qualifyFields(e.Params, imports) + ")"
+		if e.Results != nil {
+			results := qualifyFields(e.Results, imports)
+			if len(e.Results.List) > 1 || len(e.Results.List[0].Names) > 1 {
+				results = "(" + results + ")"
+			}
+			sig += " " + results
+		}
+		return sig
+	}
+	panic(fmt.Sprintf("unhandled type %T", expr))
+}
+
+// qualifyFields is qualify for the types in a parameter list.
+func qualifyFields(fields *ast.FieldList, imports map[string]bool) string {
+	var types []string
+	for _, field := range fields.List {
+		typ := qualify(field.Type, imports)
+		types = append(types, typ)
+		for i := 1; i < len(field.Names); i++ {
+			types = append(types, typ)
+		}
+	}
+	return strings.Join(types, ", ")
+}
+
+// joinFields formats names and types as a parameter list, collapsing
+// runs of the same type as in "x, y float64".
+func joinFields(names, types []string) string {
+	sig := ""
+	for i := range names {
+		if i > 0 {
+			sig += ", "
+		}
+		sig += names[i]
+		if i+1 >= len(types) || types[i] != types[i+1] {
+			sig += " " + types[i]
+		}
+	}
+	return sig
+}
+
+// checkedGen holds what genChecked learns about the cairo package.
+type checkedGen struct {
+	*Writer
+	// wrapped is the set of types that have a counterpart in checked.
+	wrapped map[string]bool
+	imports map[string]bool
+}
+
+// genFunc writes the checked counterpart of fn as a method on recv, or as
+// a plain function if recv is empty.
+func (g *checkedGen) genFunc(fn *ast.FuncDecl, recv string) {
+	var paramNames, paramTypes, callArgs []string
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			paramNames = append(paramNames, name.Name)
+			paramTypes = append(paramTypes, qualify(field.Type, g.imports))
+			callArgs = append(callArgs, name.Name)
+		}
+	}
+
+	// Results that are cairo wrapper types are wrapped again in the
+	// corresponding checked type.
+	var resultNames, resultTypes, rets, conversions []string
+	returnsErr := false
+	if fn.Type.Results != nil {
+		for i, field := range fn.Type.Results.List {
+			if id, ok := field.Type.(*ast.Ident); ok && id.Name == "error" {
+				returnsErr = true
+				rets = append(rets, "e")
+				continue
+			}
+			ret := fmt.Sprintf("r%d", i)
+			rets = append(rets, ret)
+			resultNames = append(resultNames, "_")
+			if id, ok := wrappedType(field.Type); ok && g.wrapped[id] {
+				typ := id
+				resultTypes = append(resultTypes, typ)
+				conversions = append(conversions, fmt.Sprintf("%s{%s}", typ, ret))
+			} else {
+				resultTypes = append(resultTypes, qualify(field.Type, g.imports))
+				conversions = append(conversions, ret)
+			}
+		}
+	}
+	resultNames = append(resultNames, "err")
+	resultTypes = append(resultTypes, "error")
+
+	call := fmt.Sprintf("%s(%s)", fn.Name.Name, strings.Join(callArgs, ", "))
+	if recv != "" {
+		recvName := fn.Recv.List[0].Names[0].Name
+		g.Print("// See cairo.%s.%s.", recvType(fn), fn.Name.Name)
+		g.Print("func (%s %s) %s(%s) (%s) {", recvName, recv, fn.Name.Name,
+			joinFields(paramNames, paramTypes), joinFields(resultNames, resultTypes))
+		call = fmt.Sprintf("%s.%s.%s", recvName, recv, call)
+	} else {
+		g.Print("// See cairo.%s.", fn.Name.Name)
+		g.Print("func %s(%s) (%s) {", fn.Name.Name,
+			joinFields(paramNames, paramTypes), joinFields(resultNames, resultTypes))
+		call = "cairo." + call
+	}
+	g.Print("defer catch(&err)")
+	switch {
+	case len(rets) == 0:
+		g.Print("%s", call)
+		g.Print("return nil")
+	case returnsErr && len(rets) == 1:
+		g.Print("return %s", call)
+	default:
+		g.Print("%s := %s", strings.Join(rets, ", "), call)
+		if returnsErr {
+			conversions = append(conversions, "e")
+		} else {
+			conversions = append(conversions, "nil")
+		}
+		g.Print("return %s", strings.Join(conversions, ", "))
+	}
+	g.Print("}")
+	g.Print("")
+}
+
+// wrappedType returns the name of the type expr points to, if it is a
+// pointer to a type of the cairo package.
+func wrappedType(expr ast.Expr) (string, bool) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		if id, ok := star.X.(*ast.Ident); ok {
+			return id.Name, true
+		}
+	}
+	return "", false
+}
+
+// recvType returns the name of the type fn is a method on.
+func recvType(fn *ast.FuncDecl) string {
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	return typ.(*ast.Ident).Name
+}
+
+// panicking finds the functions and methods in files that can panic:
+// those that call panic, or call something that can, unless they recover
+// the panic into an error themselves.  Calls are matched by name only,
+// so a method counts as panicking if any method of that name does.
+func panicking(files []*ast.File) map[*ast.FuncDecl]bool {
+	funcs := map[string][]*ast.FuncDecl{}
+	methods := map[string][]*ast.FuncDecl{}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				if fn.Recv == nil {
+					funcs[fn.Name.Name] = append(funcs[fn.Name.Name], fn)
+				} else {
+					methods[fn.Name.Name] = append(methods[fn.Name.Name], fn)
+				}
+			}
+		}
+	}
+
+	// callees maps each function to what it calls, or to nil if it
+	// recovers.
+	callees := map[*ast.FuncDecl][]*ast.FuncDecl{}
+	panics := map[*ast.FuncDecl]bool{}
+	for _, fns := range []map[string][]*ast.FuncDecl{funcs, methods} {
+		for _, list := range fns {
+			for _, fn := range list {
+				var calls []*ast.FuncDecl
+				direct, recovers := false, false
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					call, ok := n.(*ast.CallExpr)
+					if !ok {
+						return true
+					}
+					switch f := call.Fun.(type) {
+					case *ast.Ident:
+						switch f.Name {
+						case "panic":
+							direct = true
+						case "recover", "catchStatus":
+							recovers = true
+						default:
+							calls = append(calls, funcs[f.Name]...)
+						}
+					case *ast.SelectorExpr:
+						// Calls into other packages, like C.foo or
+						// fmt.Errorf, have no Obj.
+						if x, ok := f.X.(*ast.Ident); !ok || x.Obj != nil {
+							calls = append(calls, methods[f.Sel.Name]...)
+						}
+					}
+					return true
+				})
+				if recovers {
+					continue
+				}
+				panics[fn] = direct
+				callees[fn] = calls
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for fn, calls := range callees {
+			if panics[fn] {
+				continue
+			}
+			for _, callee := range calls {
+				if panics[callee] {
+					panics[fn] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	return panics
+}
+
+// genChecked generates the cairo/checked package from the source of the
+// cairo package: src, the generated cairo.go, plus the hand-written .go
+// files in dir.  Each exported function there that can panic gets a
+// counterpart in checked that returns the error instead, so the two
+// packages always cover the same API.
+func genChecked(src []byte, dir string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "cairo.go", src, 0)
+	if err != nil {
+		return nil, err
+	}
+	files := []*ast.File{file}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		base := filepath.Base(path)
+		if base == "cairo.go" || strings.HasSuffix(base, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
+	// Find the wrapper types, i.e. those holding a C Ptr, and their
+	// subtypes, which embed them.
+	g := &checkedGen{
+		Writer:  &Writer{},
+		wrapped: map[string]bool{},
+		imports: map[string]bool{},
+	}
+	var types []string
+	subTypes := map[string][]string{}
+	var decls []ast.Decl
+	for _, file := range files {
+		decls = append(decls, file.Decls...)
+	}
+	for _, decl := range decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts := spec.(*ast.TypeSpec)
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok || len(st.Fields.List) != 1 {
+				continue
+			}
+			field := st.Fields.List[0]
+			star, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			if len(field.Names) == 0 {
+				super := star.X.(*ast.Ident).Name
+				subTypes[super] = append(subTypes[super], ts.Name.Name)
+			} else if field.Names[0].Name != "Ptr" {
+				continue
+			}
+			types = append(types, ts.Name.Name)
+			g.wrapped[ts.Name.Name] = true
+		}
+	}
+
+	panics := panicking(files)
+	var funcs []*ast.FuncDecl
+	methods := map[string]map[string]bool{}
+	for _, decl := range decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || !fn.Name.IsExported() {
+			continue
+		}
+		if fn.Recv != nil {
+			recv := recvType(fn)
+			if methods[recv] == nil {
+				methods[recv] = map[string]bool{}
+			}
+			methods[recv][fn.Name.Name] = true
+			if !g.wrapped[recv] {
+				continue
+			}
+		}
+		if panics[fn] {
+			funcs = append(funcs, fn)
+		}
+	}
+
+	for _, t := range types {
+		g.Print("// %s wraps a *cairo.%s.  Its methods return errors instead of panicking.", t, t)
+		g.Print("type %s struct {", t)
+		g.Print("*cairo.%s", t)
+		g.Print("}")
+		g.Print("")
+	}
+	for _, fn := range funcs {
+		if fn.Recv == nil {
+			g.genFunc(fn, "")
+			continue
+		}
+		recv := recvType(fn)
+		g.genFunc(fn, recv)
+		// Subtypes need their own copy, or the panicking method would
+		// be promoted through the embedded cairo type.
+		for _, sub := range subTypes[recv] {
+			if !methods[sub][fn.Name.Name] {
+				g.genFunc(fn, sub)
+			}
+		}
+	}
+
+	// Map package names used in signatures back to import paths.
+	importPaths := map[string]string{}
+	for _, file := range files {
+		for _, imp := range file.Imports {
+			path := strings.Trim(imp.Path.Value, `"`)
+			importPaths[path[strings.LastIndex(path, "/")+1:]] = path
+		}
+	}
+	var imports []string
+	for pkg := range g.imports {
+		imports = append(imports, importPaths[pkg])
+	}
+	sort.Strings(imports)
+
+	out := &Writer{}
+	out.Print(`// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Autogenerated by gen.go -checked, do not edit.
+
+package checked
+
+import (`)
+	for _, imp := range imports {
+		out.Print("%q", imp)
+	}
+	out.Print("")
+	out.Print(`"github.com/martine/gocairo/cairo"`)
+	out.Print(")")
+	out.Print("")
+	out.Write(g.Bytes())
+	return out.Source(), nil
+}
+
 func loadDevHelp(path string) (map[string]string, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -920,6 +2439,9 @@ func generateInputHeader(headerPath string, features []string) error {
 		if feature == "cairo-xlib" {
 			fmt.Fprintf(f, "#include \"fake-xlib.h\"\n")
 		}
+		if feature == "cairo-ft" {
+			fmt.Fprintf(f, "#include \"fake-ft.h\"\n")
+		}
 		fmt.Fprintf(f, "#include <cairo/%s.h>\n", feature)
 	}
 
@@ -967,10 +2489,13 @@ func generateHeader(outHeaderPath string, features []string) error {
 }
 
 func main() {
+	checked := flag.Bool("checked", false, "generate the cairo/checked package instead of cairo")
+	flag.Parse()
+
 	// features is a map from pkg-config name to whether the cairo
 	// install has that feature.  It is filled in by probing
 	// pkg-config.
-	features := checkCairoFeatures("cairo-svg", "cairo-xlib")
+	features := checkCairoFeatures("cairo-ft", "cairo-pdf", "cairo-ps", "cairo-svg", "cairo-xlib", "cairo-script", "cairo-tee")
 	log.Printf("cairo features: %v", features)
 
 	headerPath := "cairo-preprocessed.h"
@@ -994,10 +2519,19 @@ func main() {
 		os.Exit(1)
 	}
 
-	w := &Writer{links: links}
+	w := &Writer{links: links, features: features}
 	w.process(prog.Decls)
 
-	_, err = os.Stdout.Write(w.Source())
+	src := w.Source()
+	if *checked {
+		src, err = genChecked(src, "cairo")
+		if err != nil {
+			log.Printf("checked: %s", err)
+			os.Exit(1)
+		}
+	}
+
+	_, err = os.Stdout.Write(src)
 	if err != nil {
 		log.Printf("write: %s", err)
 		os.Exit(1)
@@ -0,0 +1,55 @@
+// Copyright 2015 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stash holds Go data for C callbacks.  Since Go 1.6, Go
+// pointers can't be passed through C, so C holds an integer key into a
+// Stash instead.
+package stash
+
+import "sync"
+
+// Stash maps integer keys to arbitrary Go data.  The zero value is
+// ready to use.
+type Stash struct {
+	sync.Mutex
+	data    map[int]interface{}
+	nextKey int
+}
+
+// Put stashes data and returns its key.
+func (s *Stash) Put(data interface{}) int {
+	s.Lock()
+	defer s.Unlock()
+	if s.data == nil {
+		s.data = make(map[int]interface{})
+	}
+	key := s.nextKey
+	s.nextKey++
+	s.data[key] = data
+	return key
+}
+
+// Get returns the data stashed under key.
+func (s *Stash) Get(key int) interface{} {
+	s.Lock()
+	defer s.Unlock()
+	return s.data[key]
+}
+
+// Clear removes the data stashed under key.
+func (s *Stash) Clear(key int) {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.data, key)
+}